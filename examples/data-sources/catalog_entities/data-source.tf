@@ -0,0 +1,10 @@
+data "cortex_catalog_entities" "payments" {
+  types  = ["service"]
+  groups = ["payments"]
+}
+
+output "payments_service_owners" {
+  value = {
+    for entity in data.cortex_catalog_entities.payments.entities : entity.tag => entity.owners.groups
+  }
+}
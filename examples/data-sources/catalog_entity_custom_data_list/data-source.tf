@@ -0,0 +1,10 @@
+data "cortex_catalog_entity_custom_data_list" "products" {
+  tag    = "products-service"
+  source = "API"
+}
+
+output "products_custom_data" {
+  value = {
+    for key, entry in data.cortex_catalog_entity_custom_data_list.products.custom_data : key => jsondecode(entry.value_json)
+  }
+}
@@ -0,0 +1,10 @@
+data "cortex_catalog_entity_packages" "products" {
+  tag  = "products-service"
+  type = "NODE"
+}
+
+output "products_node_packages" {
+  value = {
+    for pkg in data.cortex_catalog_entity_packages.products.packages : pkg.name => pkg.version
+  }
+}
@@ -1,3 +1,10 @@
 data "cortex_team" "engineering" {
   tag = "platform-engineering"
 }
+
+output "engineering_member_emails" {
+  value = concat(
+    [for member in data.cortex_team.engineering.additional_members : member.email],
+    data.cortex_team.engineering.idp_group != null ? [for member in data.cortex_team.engineering.idp_group.members : member.email] : [],
+  )
+}
@@ -0,0 +1,10 @@
+data "cortex_teams" "active" {
+  archived  = false
+  tag_regex = "^platform-"
+}
+
+output "active_team_slack_channels" {
+  value = {
+    for tag, team in data.cortex_teams.active.teams : tag => [for channel in team.slack_channels : channel.name]
+  }
+}
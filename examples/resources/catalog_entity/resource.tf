@@ -58,6 +58,9 @@ resource "cortex_catalog_entity" "products-service" {
     ]
   })
 
+  # The spec's info block is merged with the one generated from the attributes above.
+  api_spec = file("${path.module}/products-openapi.yaml")
+
   dependencies = [
     {
       tag         = "variants-service"
@@ -288,6 +291,50 @@ resource "cortex_catalog_entity" "products-service" {
     ]
   }
 
+  infra = {
+    aws = {
+      cloud_control = [
+        {
+          type       = "AWS::RDS::DBInstance"
+          region     = "us-west-2"
+          account_id = "123456123456"
+          identifier = "products-db"
+        }
+      ]
+      ecs = [
+        {
+          cluster_arn = "arn:aws:ecs:us-west-2:123456123456:cluster/core"
+          service_arn = "arn:aws:ecs:us-west-2:123456123456:service/core/products-service"
+        }
+      ]
+    }
+    gcp = {
+      resources = [
+        {
+          resource_name = "us-central1/products-function"
+          project_id    = "products-project"
+          resource_type = "function"
+        }
+      ]
+    }
+    azure = {
+      resources = [
+        {
+          id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/products"
+        }
+      ]
+    }
+  }
+
+  relationships = [
+    {
+      type = "runs-on"
+      destinations = [
+        { tag = "prod-cluster" },
+      ]
+    },
+  ]
+
   k8s = {
     deployments = [
       {
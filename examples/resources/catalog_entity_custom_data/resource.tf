@@ -19,3 +19,10 @@ resource "cortex_catalog_entity_custom_data" "data-with-nested-key" {
     "instances" : 3,
   })
 }
+
+resource "cortex_catalog_entity_custom_data" "data-with-number" {
+  tag         = "products-service"
+  key         = "replicas"
+  description = "Sent as the number 3. Use jsonencode(\"3\") for the string \"3\"."
+  value       = jsonencode(3)
+}
@@ -0,0 +1,24 @@
+resource "cortex_catalog_entity_custom_data_set" "products-service" {
+  tag = "products-service"
+
+  # Leave keys from the entity's descriptor alone, and only delete stray keys set through the API.
+  prune_api_source_only = true
+
+  custom_data = {
+    region = {
+      value       = "us-central1"
+      description = "The region where the product is available"
+    }
+    "deployment pipeline" = {
+      description = "Deployment pipeline information"
+      value = jsonencode({
+        "environments" : [
+          "integration",
+          "staging",
+          "production",
+        ],
+        "instances" : 3,
+      })
+    }
+  }
+}
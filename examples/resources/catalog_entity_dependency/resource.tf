@@ -0,0 +1,17 @@
+# Owned by the team that owns checkout-service, without editing products-service.
+resource "cortex_catalog_entity_dependency" "checkout-lists-products" {
+  caller_tag  = "checkout-service"
+  callee_tag  = "products-service"
+  method      = "GET"
+  path        = "/api/v1/products"
+  description = "Looks up the products in a cart"
+  metadata = jsonencode({
+    tier = "critical"
+  })
+}
+
+# A dependency on the callee as a whole, rather than one of its endpoints.
+resource "cortex_catalog_entity_dependency" "checkout-uses-payments" {
+  caller_tag = "checkout-service"
+  callee_tag = "payments-service"
+}
@@ -0,0 +1,23 @@
+# Manage an entity from the cortex.yaml file in its repository.
+resource "cortex_catalog_entity_descriptor" "products-service" {
+  descriptor = file("${path.module}/products-service/cortex.yaml")
+}
+
+# Or build the descriptor in Terraform.
+resource "cortex_catalog_entity_descriptor" "orders-service" {
+  descriptor = yamlencode({
+    openapi = "3.0.1"
+    info = {
+      title           = "Orders Service"
+      x-cortex-tag    = "orders-service"
+      x-cortex-type   = "service"
+      x-cortex-groups = ["commerce"]
+      x-cortex-owners = [
+        {
+          type = "group"
+          name = "commerce-team"
+        }
+      ]
+    }
+  })
+}
@@ -0,0 +1,16 @@
+resource "cortex_catalog_entity_packages" "products-service" {
+  tag = "products-service"
+
+  packages = [
+    {
+      type    = "JAVA"
+      name    = "com.example:billing-client"
+      version = "1.8.3"
+    },
+    {
+      type    = "JAVA"
+      name    = "com.example:design-system"
+      version = "4.2.0"
+    },
+  ]
+}
@@ -0,0 +1,22 @@
+resource "cortex_relationship_type" "runs-on" {
+  tag               = "runs-on"
+  name              = "Runs on"
+  description       = "Kubernetes clusters that a service runs on."
+  source_types      = ["service"]
+  destination_types = ["k8s-cluster"]
+}
+
+resource "cortex_catalog_entity" "checkout-service" {
+  tag  = "checkout-service"
+  name = "Checkout Service"
+
+  relationships = [
+    {
+      type = cortex_relationship_type.runs-on.tag
+      destinations = [
+        { tag = "prod-cluster" },
+        { tag = "staging-cluster" },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,47 @@
+resource "cortex_team" "payments" {
+  tag         = "payments"
+  name        = "Payments"
+  summary     = "Card payments and refunds"
+  description = "Owns everything involved in taking money from customers."
+
+  idp_group = {
+    group    = "payments-eng"
+    provider = "OKTA"
+  }
+
+  additional_members = [
+    {
+      name  = "Jane Doe"
+      email = "jane.doe@example.com"
+    }
+  ]
+
+  slack_channels = [
+    {
+      name                  = "payments-alerts"
+      notifications_enabled = true
+    }
+  ]
+
+  links = [
+    {
+      name = "Runbook"
+      type = "runbook"
+      url  = "https://runbooks.example.com/payments"
+    }
+  ]
+}
+
+resource "cortex_team" "platform" {
+  tag  = "platform"
+  name = "Platform"
+
+  cortex_team = {
+    members = [
+      {
+        name  = "John Doe"
+        email = "john.doe@example.com"
+      }
+    ]
+  }
+}
@@ -3,13 +3,21 @@ package cortex
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
+// Sentinel errors that an *ApiError matches with errors.Is, based on its HTTP status.
 var (
 	ApiErrorNotFound     = errors.New("not found")
 	ApiErrorUnauthorized = errors.New("unauthorized")
+	ApiErrorConflict     = errors.New("conflict")
+	ApiErrorRateLimited  = errors.New("rate limited")
+	ApiErrorValidation   = errors.New("validation failed")
 )
 
+// ApiError is the error payload returned by the Cortex API. It is returned as an error by every client method when
+// the API responds with a non-2xx status, so callers can inspect it with errors.As or match it with errors.Is.
 type ApiError struct {
 	Details           string `json:"details"`
 	GatewayHttpStatus int    `json:"gatewayHttpStatus"`
@@ -19,6 +27,8 @@ type ApiError struct {
 	Type              string `json:"type"`
 }
 
+var _ error = &ApiError{}
+
 func (err ApiError) String() string {
 	str := ""
 	if err.Type != "" {
@@ -45,3 +55,48 @@ func (err ApiError) String() string {
 
 	return str
 }
+
+// Error renders the error on a single line, always including the request ID so it can be quoted to Cortex support.
+func (err *ApiError) Error() string {
+	parts := []string{fmt.Sprintf("%d %s", err.HttpStatus, http.StatusText(err.HttpStatus))}
+	if err.Type != "" {
+		parts = append(parts, err.Type)
+	}
+	if err.Message != "" {
+		parts = append(parts, err.Message)
+	}
+	if err.Details != "" && err.Details != err.Message {
+		parts = append(parts, err.Details)
+	}
+	str := strings.Join(parts, ": ")
+	if err.RequestId != "" {
+		str += fmt.Sprintf(" (requestId: %s)", err.RequestId)
+	}
+	return str
+}
+
+// Unwrap returns the sentinel error matching the HTTP status, if any.
+func (err *ApiError) Unwrap() error {
+	switch err.HttpStatus {
+	case http.StatusNotFound:
+		return ApiErrorNotFound
+	case http.StatusUnauthorized:
+		return ApiErrorUnauthorized
+	case http.StatusConflict:
+		return ApiErrorConflict
+	case http.StatusTooManyRequests:
+		return ApiErrorRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ApiErrorValidation
+	}
+	return nil
+}
+
+// Is reports whether the error matches target. Two *ApiError values match when their HTTP statuses are equal.
+func (err *ApiError) Is(target error) bool {
+	var t *ApiError
+	if errors.As(target, &t) {
+		return t.HttpStatus == err.HttpStatus
+	}
+	return false
+}
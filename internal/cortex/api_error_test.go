@@ -1,8 +1,11 @@
 package cortex_test
 
 import (
+	"context"
+	"errors"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 )
 
@@ -22,3 +25,56 @@ func TestApiErrorString(t *testing.T) {
 	assert.Contains(t, testApiError.String(), "gateway status: 201")
 	assert.Contains(t, testApiError.String(), "requestId: test-request-id")
 }
+
+func TestApiErrorIsSentinel(t *testing.T) {
+	tests := map[int]error{
+		404: cortex.ApiErrorNotFound,
+		401: cortex.ApiErrorUnauthorized,
+		409: cortex.ApiErrorConflict,
+		429: cortex.ApiErrorRateLimited,
+		400: cortex.ApiErrorValidation,
+		422: cortex.ApiErrorValidation,
+	}
+	for status, sentinel := range tests {
+		err := &cortex.ApiError{HttpStatus: status}
+		assert.ErrorIs(t, err, sentinel, "expected %d to match %s", status, sentinel)
+	}
+	assert.NotErrorIs(t, &cortex.ApiError{HttpStatus: 500}, cortex.ApiErrorNotFound)
+	assert.ErrorIs(t, &cortex.ApiError{HttpStatus: 500, RequestId: "a"}, &cortex.ApiError{HttpStatus: 500})
+}
+
+func TestApiErrorMessageIncludesRequestId(t *testing.T) {
+	err := &cortex.ApiError{
+		HttpStatus: 409,
+		Type:       "CONFLICT",
+		Message:    "entity already exists",
+		RequestId:  "test-request-id",
+	}
+	assert.Equal(t, "409 Conflict: CONFLICT: entity already exists (requestId: test-request-id)", err.Error())
+}
+
+func TestClientReturnsTypedApiError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(cortex.Route("teams", "test-team"), func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"type":"CONFLICT","message":"team exists","requestId":"abc-123"}`))
+	})
+	mux.HandleFunc(cortex.Route("teams", "missing-team"), func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<html>not found</html>`))
+	})
+	c, teardown, err := buildClient(mux)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.Teams().Get(context.Background(), "test-team")
+	assert.ErrorIs(t, err, cortex.ApiErrorConflict)
+	var apiError *cortex.ApiError
+	assert.True(t, errors.As(err, &apiError), "expected an *ApiError")
+	assert.Equal(t, "abc-123", apiError.RequestId)
+	assert.Equal(t, "CONFLICT", apiError.Type)
+	assert.Equal(t, http.StatusConflict, apiError.HttpStatus)
+
+	_, err = c.Teams().Get(context.Background(), "missing-team")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound, "expected a non-JSON 404 body to still yield ApiErrorNotFound")
+}
@@ -7,14 +7,19 @@ import (
 	"github.com/dghubble/sling"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"gopkg.in/yaml.v3"
+	"iter"
 	"strings"
 )
 
 type CatalogEntitiesClientInterface interface {
 	Get(ctx context.Context, tag string) (*CatalogEntity, error)
 	GetFromDescriptor(ctx context.Context, tag string) (CatalogEntityData, error)
+	GetDescriptor(ctx context.Context, tag string) (map[string]interface{}, error)
 	List(ctx context.Context, params *CatalogEntityListParams) (*CatalogEntitiesResponse, error)
+	ListPage(ctx context.Context, params *CatalogEntityListParams) (*CatalogEntitiesResponse, error)
+	Iterate(ctx context.Context, params *CatalogEntityListParams) iter.Seq2[CatalogEntity, error]
 	Upsert(ctx context.Context, req UpsertCatalogEntityRequest) (CatalogEntityData, error)
+	UpsertDescriptor(ctx context.Context, descriptor string) (map[string]interface{}, error)
 	Delete(ctx context.Context, tag string) error
 }
 
@@ -25,12 +30,12 @@ type CatalogEntitiesClient struct {
 
 var _ CatalogEntitiesClientInterface = &CatalogEntitiesClient{}
 
-func (c *CatalogEntitiesClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *CatalogEntitiesClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
-func (c *CatalogEntitiesClient) YamlClient() *sling.Sling {
-	return c.client.YamlClient()
+func (c *CatalogEntitiesClient) YamlClient(ctx context.Context) *sling.Sling {
+	return c.client.YamlClient(ctx)
 }
 
 /***********************************************************************************************************************
@@ -95,7 +100,7 @@ func (v *CatalogEntityViolation) String() string {
 func (c *CatalogEntitiesClient) Get(ctx context.Context, tag string) (*CatalogEntity, error) {
 	catalogEntityResponse := &CatalogEntity{}
 	apiError := &ApiError{}
-	response, err := c.Client().Get(Route("catalog_entities", tag)).Receive(catalogEntityResponse, apiError)
+	response, err := c.Client(ctx).Get(Route("catalog_entities", tag)).Receive(catalogEntityResponse, apiError)
 	if err != nil {
 		return catalogEntityResponse, errors.New("could not get catalog entity: " + err.Error())
 	}
@@ -113,6 +118,16 @@ type CatalogEntityGetDescriptorParams struct {
 }
 
 func (c *CatalogEntitiesClient) GetFromDescriptor(ctx context.Context, tag string) (CatalogEntityData, error) {
+	entityDescriptorResponse, err := c.GetDescriptor(ctx, tag)
+	if err != nil {
+		return CatalogEntityData{}, err
+	}
+	return c.parser.YamlToEntity(entityDescriptorResponse)
+}
+
+// GetDescriptor retrieves the OpenAPI descriptor of a catalog entity as it is stored, including any x-cortex-* keys
+// that CatalogEntityParser does not know about.
+func (c *CatalogEntitiesClient) GetDescriptor(ctx context.Context, tag string) (map[string]interface{}, error) {
 	entityDescriptorResponse := map[string]interface{}{}
 
 	apiError := &ApiError{}
@@ -120,20 +135,20 @@ func (c *CatalogEntitiesClient) GetFromDescriptor(ctx context.Context, tag strin
 		Yaml: true,
 	}
 	uri := Route("catalog_entities", tag+"/openapi")
-	cl := c.YamlClient().Get(uri).QueryStruct(params)
+	cl := c.YamlClient(ctx).Get(uri).QueryStruct(params)
 	response, err := cl.Receive(entityDescriptorResponse, apiError)
 	if err != nil {
-		return CatalogEntityData{}, errors.Join(fmt.Errorf("failed getting catalog entity descriptor for %s from %s", tag, uri), err)
+		return nil, errors.Join(fmt.Errorf("failed getting catalog entity descriptor for %s from %s", tag, uri), err)
 	}
 
 	err = c.client.handleResponseStatus(response, apiError)
 	if err != nil {
-		return CatalogEntityData{}, errors.Join(fmt.Errorf("failed handling response status for %s from %s", tag, uri), err)
+		return nil, errors.Join(fmt.Errorf("failed handling response status for %s from %s", tag, uri), err)
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("body: %+v", entityDescriptorResponse))
 
-	return c.parser.YamlToEntity(entityDescriptorResponse)
+	return entityDescriptorResponse, nil
 }
 
 /***********************************************************************************************************************
@@ -145,19 +160,65 @@ type CatalogEntityListParams struct {
 	Groups          []string `url:"groups,omitempty"`
 	Types           []string `url:"types,omitempty"`
 	GitRepositories []string `url:"gitRepositories,omitempty"`
+	Owners          []string `url:"owners,omitempty"`
+	Query           string   `url:"query,omitempty"`
+	Page            int      `url:"page,omitempty"`
+	PageSize        int      `url:"pageSize,omitempty"`
 }
 
-// CatalogEntitiesResponse is the response from the GET /v1/scorecards endpoint.
+// CatalogEntitiesResponse is the response from the GET /v1/catalog endpoint.
 type CatalogEntitiesResponse struct {
-	Entities []CatalogEntity `json:"entities" yaml:"entities"`
+	Entities   []CatalogEntity `json:"entities" yaml:"entities"`
+	Page       int             `json:"page" yaml:"page"`
+	Total      int             `json:"total" yaml:"total"`
+	TotalPages int             `json:"totalPages" yaml:"totalPages"`
 }
 
-// List retrieves a list of scorecards based on a query.
+// List retrieves every catalog entity matching a query, fetching each page from params.Page onwards.
 func (c *CatalogEntitiesClient) List(ctx context.Context, params *CatalogEntityListParams) (*CatalogEntitiesResponse, error) {
+	p := CatalogEntityListParams{}
+	if params != nil {
+		p = *params
+	}
+	result, err := collectPages(c.pages(ctx, p))
+	if err != nil {
+		return nil, err
+	}
+	return &CatalogEntitiesResponse{
+		Entities:   result.Items,
+		Page:       p.Page,
+		Total:      result.Total,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+// Iterate returns an iterator over every catalog entity matching a query, fetching each page from params.Page onwards
+// only once the entities on the previous page have been consumed.
+func (c *CatalogEntitiesClient) Iterate(ctx context.Context, params *CatalogEntityListParams) iter.Seq2[CatalogEntity, error] {
+	p := CatalogEntityListParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginatedItems(c.pages(ctx, p))
+}
+
+func (c *CatalogEntitiesClient) pages(ctx context.Context, params CatalogEntityListParams) iter.Seq2[listPage[CatalogEntity], error] {
+	return paginate(ctx, params.Page, func(ctx context.Context, page int) (listPage[CatalogEntity], error) {
+		params.Page = page
+		resp, err := c.ListPage(ctx, &params)
+		if err != nil {
+			return listPage[CatalogEntity]{}, err
+		}
+		return listPage[CatalogEntity]{Items: resp.Entities, Total: resp.Total, TotalPages: resp.TotalPages}, nil
+	})
+}
+
+// ListPage retrieves a single page of catalog entities matching a query.
+func (c *CatalogEntitiesClient) ListPage(ctx context.Context, params *CatalogEntityListParams) (*CatalogEntitiesResponse, error) {
 	entitiesResponse := &CatalogEntitiesResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Get(Route("catalog_entities", "")).QueryStruct(&params).Receive(entitiesResponse, apiError)
+	response, err := c.Client(ctx).Get(Route("catalog_entities", "")).QueryStruct(params).Receive(entitiesResponse, apiError)
 	if err != nil {
 		return nil, errors.New("could not get entities: " + err.Error())
 	}
@@ -174,23 +235,31 @@ func (c *CatalogEntitiesClient) List(ctx context.Context, params *CatalogEntityL
  * POST /api/v1/open-api
  **********************************************************************************************************************/
 
+// DefaultOpenApiVersion is the OpenAPI version sent with descriptors that do not set one.
+const DefaultOpenApiVersion = "3.0.1"
+
 type UpsertCatalogEntityRequest struct {
 	Info    CatalogEntityData `json:"info"`
 	OpenApi string            `json:"openapi"`
 }
 
+// MarshalYAML merges the API spec of the entity, if it has one, into the descriptor, so that its paths, components and
+// other keys are stored along with the entity.
+func (r UpsertCatalogEntityRequest) MarshalYAML() (interface{}, error) {
+	if len(r.Info.ApiSpec) == 0 {
+		type plainUpsertCatalogEntityRequest UpsertCatalogEntityRequest
+		return plainUpsertCatalogEntityRequest(r), nil
+	}
+	return mergeApiSpec(r.Info.ApiSpec, r.Info, r.OpenApi)
+}
+
 type UpsertCatalogEntityResponse struct {
 	Ok         bool                     `json:"ok"`
 	Violations []CatalogEntityViolation `json:"violations"`
 }
 
 func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEntityRequest) (CatalogEntityData, error) {
-	req.OpenApi = "3.0.1"
-	upsertResponse := &UpsertCatalogEntityResponse{
-		Ok:         false,
-		Violations: []CatalogEntityViolation{},
-	}
-	apiError := &ApiError{}
+	req.OpenApi = DefaultOpenApiVersion
 	if req.Info.IgnoreMetadata {
 		req.Info.Metadata = nil
 	}
@@ -200,23 +269,68 @@ func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEnt
 	if err != nil {
 		return CatalogEntityData{}, errors.New("could not marshal yaml: " + err.Error())
 	}
-	body := strings.NewReader(string(bytes))
 
-	tflog.Info(ctx, fmt.Sprintf("CREATE body: %+v", body))
-	response, err := c.Client().
+	tflog.Info(ctx, fmt.Sprintf("CREATE body: %+v", string(bytes)))
+	if err := c.postDescriptor(ctx, string(bytes)); err != nil {
+		return CatalogEntityData{}, err
+	}
+
+	// re-fetch the catalog entity, since it's not returned here
+	return c.GetFromDescriptor(ctx, req.Info.Tag)
+}
+
+// UpsertDescriptor creates or updates a catalog entity from a raw OpenAPI descriptor in YAML or JSON, such as the
+// contents of a cortex.yaml file, and returns the descriptor as stored by Cortex. Unlike Upsert, every key in the
+// descriptor is sent as it is, including x-cortex-* keys that CatalogEntityParser does not know about.
+func (c *CatalogEntitiesClient) UpsertDescriptor(ctx context.Context, descriptor string) (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(descriptor), &doc); err != nil {
+		return nil, errors.New("could not parse descriptor: " + err.Error())
+	}
+	tag, err := DescriptorTag(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// The API rejects descriptors without an OpenAPI version, so default it as Upsert does.
+	if _, ok := doc["openapi"]; !ok {
+		doc["openapi"] = DefaultOpenApiVersion
+		bytes, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, errors.New("could not marshal yaml: " + err.Error())
+		}
+		descriptor = string(bytes)
+	}
+
+	if err := c.postDescriptor(ctx, descriptor); err != nil {
+		return nil, err
+	}
+
+	// re-fetch the descriptor, since it's not returned here
+	return c.GetDescriptor(ctx, tag)
+}
+
+// postDescriptor submits a YAML descriptor to the open-api endpoint, turning any violations it reports into an error.
+func (c *CatalogEntitiesClient) postDescriptor(ctx context.Context, descriptor string) error {
+	upsertResponse := &UpsertCatalogEntityResponse{
+		Ok:         false,
+		Violations: []CatalogEntityViolation{},
+	}
+	apiError := &ApiError{}
+
+	response, err := c.Client(ctx).
 		Set("Content-Type", "application/openapi;charset=UTF-8").
 		Post(Route("open_api", "")).
-		Body(body).
+		Body(strings.NewReader(descriptor)).
 		Receive(upsertResponse, apiError)
 	if err != nil {
-		return CatalogEntityData{}, errors.New("could not upsert catalog entity: " + err.Error())
+		return errors.New("could not upsert catalog entity: " + err.Error())
 	}
 
 	err = c.client.handleResponseStatus(response, apiError)
 	if err != nil {
-		reqYaml, _ := yaml.Marshal(req)
-		tflog.Error(ctx, fmt.Sprintf("Failed upserting catalog entity: %+v\n\nRequest:\n%+v\n%+v", err, string(reqYaml), apiError.String()))
-		return CatalogEntityData{}, err
+		tflog.Error(ctx, fmt.Sprintf("Failed upserting catalog entity: %+v\n\nRequest:\n%+v\n%+v", err, descriptor, apiError.String()))
+		return err
 	}
 
 	// coerce violations into an error
@@ -225,11 +339,22 @@ func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEnt
 		for _, v := range upsertResponse.Violations {
 			o += v.String() + "\n"
 		}
-		return CatalogEntityData{}, errors.New(o)
+		return errors.New(o)
 	}
+	return nil
+}
 
-	// re-fetch the catalog entity, since it's not returned here
-	return c.GetFromDescriptor(ctx, req.Info.Tag)
+// DescriptorTag returns the x-cortex-tag in the info block of an OpenAPI descriptor.
+func DescriptorTag(descriptor map[string]interface{}) (string, error) {
+	info, ok := descriptor["info"].(map[string]interface{})
+	if !ok {
+		return "", errors.New("descriptor has no info block")
+	}
+	tag, ok := info["x-cortex-tag"].(string)
+	if !ok || tag == "" {
+		return "", errors.New("descriptor has no info.x-cortex-tag")
+	}
+	return tag, nil
 }
 
 /***********************************************************************************************************************
@@ -239,7 +364,7 @@ func (c *CatalogEntitiesClient) Upsert(ctx context.Context, req UpsertCatalogEnt
 func (c *CatalogEntitiesClient) Delete(ctx context.Context, tag string) error {
 	apiError := &ApiError{}
 
-	response, err := c.Client().Delete(Route("catalog_entities", tag)).Receive(nil, apiError)
+	response, err := c.Client(ctx).Delete(Route("catalog_entities", tag)).Receive(nil, apiError)
 	if err != nil {
 		return errors.New("could not delete catalog entity: " + err.Error())
 	}
@@ -2,8 +2,12 @@ package cortex_test
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"io"
+	"net/http"
 	"testing"
 )
 
@@ -39,3 +43,118 @@ func TestListCatalogEntities(t *testing.T) {
 	assert.NotEmpty(t, res.Entities, "returned no entities")
 	assert.Equal(t, res.Entities[0].Tag, firstTag)
 }
+
+func TestListCatalogEntitiesPageWithFilters(t *testing.T) {
+	resp := &cortex.CatalogEntitiesResponse{
+		Entities:   []cortex.CatalogEntity{*testCatalogEntity},
+		Page:       1,
+		Total:      3,
+		TotalPages: 3,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", ""),
+		resp,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", "")+"?groups=payments&owners=platform-engineering&page=1&pageSize=1&query=checkout&types=service"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntities().ListPage(context.Background(), &cortex.CatalogEntityListParams{
+		Groups:   []string{"payments"},
+		Types:    []string{"service"},
+		Owners:   []string{"platform-engineering"},
+		Query:    "checkout",
+		Page:     1,
+		PageSize: 1,
+	})
+	assert.Nil(t, err, "error retrieving entities")
+	assert.Equal(t, resp, res)
+}
+
+func TestUpsertCatalogEntityDescriptor(t *testing.T) {
+	descriptor := `info:
+  title: Descriptor Service
+  x-cortex-tag: descriptor-service
+  x-cortex-future-key:
+    enabled: true
+`
+	var posted string
+	mux := http.NewServeMux()
+	mux.HandleFunc(cortex.Route("open_api", ""), func(w http.ResponseWriter, req *http.Request) {
+		defer req.Body.Close()
+		assert.Equal(t, http.MethodPost, req.Method)
+		body, _ := io.ReadAll(req.Body)
+		posted = string(body)
+		_ = json.NewEncoder(w).Encode(cortex.UpsertCatalogEntityResponse{Ok: true})
+	})
+	mux.HandleFunc(cortex.Route("catalog_entities", "descriptor-service/openapi"), func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte("openapi: 3.0.1\n" + descriptor))
+	})
+	c, teardown, err := buildClient(mux)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntities().UpsertDescriptor(context.Background(), descriptor)
+	assert.Nil(t, err, "error upserting descriptor")
+
+	sent := map[string]interface{}{}
+	assert.Nil(t, yaml.Unmarshal([]byte(posted), &sent))
+	assert.Equal(t, cortex.DefaultOpenApiVersion, sent["openapi"], "expected the default OpenAPI version to be added")
+	assert.Equal(t, map[string]interface{}{"enabled": true}, sent["info"].(map[string]interface{})["x-cortex-future-key"])
+	assert.Equal(t, "descriptor-service", res["info"].(map[string]interface{})["x-cortex-tag"])
+	assert.Equal(t, map[string]interface{}{"enabled": true}, res["info"].(map[string]interface{})["x-cortex-future-key"])
+}
+
+func TestUpsertCatalogEntityDescriptorRequiresTag(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("open_api", ""), cortex.UpsertCatalogEntityResponse{Ok: true})
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.CatalogEntities().UpsertDescriptor(context.Background(), "info:\n  title: No Tag\n")
+	assert.ErrorContains(t, err, "x-cortex-tag")
+}
+
+func TestUpsertCatalogEntityWithApiSpec(t *testing.T) {
+	var posted []byte
+	mux := http.NewServeMux()
+	mux.HandleFunc(cortex.Route("open_api", ""), func(w http.ResponseWriter, req *http.Request) {
+		defer req.Body.Close()
+		posted, _ = io.ReadAll(req.Body)
+		_ = json.NewEncoder(w).Encode(cortex.UpsertCatalogEntityResponse{Ok: true})
+	})
+	mux.HandleFunc(cortex.Route("catalog_entities", "api-spec-service/openapi"), func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write(posted)
+	})
+	c, teardown, err := buildClient(mux)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "Payments API",
+			"version": "1.2.0",
+		},
+		"paths": map[string]interface{}{
+			"/payments": map[string]interface{}{"get": map[string]interface{}{"summary": "List payments"}},
+		},
+	}
+	res, err := c.CatalogEntities().Upsert(context.Background(), cortex.UpsertCatalogEntityRequest{
+		Info: cortex.CatalogEntityData{Tag: "api-spec-service", Title: "API Spec Service", ApiSpec: spec},
+	})
+	assert.Nil(t, err, "error upserting catalog entity")
+
+	sent := map[string]interface{}{}
+	assert.Nil(t, yaml.Unmarshal(posted, &sent))
+	assert.Equal(t, "3.0.3", sent["openapi"], "expected the OpenAPI version of the spec")
+	assert.Equal(t, spec["paths"], sent["paths"])
+	info := sent["info"].(map[string]interface{})
+	assert.Equal(t, "API Spec Service", info["title"], "expected the entity title to take precedence")
+	assert.Equal(t, "1.2.0", info["version"])
+	assert.Equal(t, "api-spec-service", info["x-cortex-tag"])
+
+	assert.Equal(t, "API Spec Service", res.Title)
+	assert.Equal(t, map[string]interface{}{"version": "1.2.0"}, res.ApiSpec["info"])
+	assert.Equal(t, spec["paths"], res.ApiSpec["paths"])
+}
@@ -0,0 +1,81 @@
+package cortex
+
+import (
+	"gopkg.in/yaml.v3"
+	"strings"
+)
+
+/***********************************************************************************************************************
+ * API Specs - https://docs.cortex.io/docs/reference/basics/api-docs
+ **********************************************************************************************************************/
+
+// ApiSpecFromDescriptor returns the OpenAPI or AsyncAPI spec attached to an entity: every key of its descriptor except
+// info, and the keys of its info block that are not generated from the entity, such as version. Maps with keys that
+// are not strings, such as the status codes of OpenAPI responses, are converted to maps with string keys. It returns
+// nil when the descriptor has nothing but the default OpenAPI version.
+func ApiSpecFromDescriptor(descriptor map[string]interface{}) map[string]interface{} {
+	spec := map[string]interface{}{}
+	for k, v := range descriptor {
+		if k != "info" {
+			spec[k] = StringKeys(v)
+		}
+	}
+	if info, ok := StringKeys(descriptor["info"]).(map[string]interface{}); ok {
+		specInfo := map[string]interface{}{}
+		for k, v := range info {
+			if !isGeneratedInfoKey(k) {
+				specInfo[k] = v
+			}
+		}
+		if len(specInfo) > 0 {
+			spec["info"] = specInfo
+		}
+	}
+
+	if len(spec) == 0 || (len(spec) == 1 && spec["openapi"] == DefaultOpenApiVersion) {
+		return nil
+	}
+	return spec
+}
+
+// isGeneratedInfoKey reports whether a key of the info block of a descriptor is generated from the entity, rather than
+// being part of its API spec.
+func isGeneratedInfoKey(key string) bool {
+	return key == "title" || key == "description" || strings.HasPrefix(key, "x-cortex-")
+}
+
+// mergeApiSpec returns a descriptor with the keys of the spec and the info block of the entity. The keys of the entity
+// take precedence over the ones in the info block of the spec, and the OpenAPI or AsyncAPI version of the spec over
+// openApi.
+func mergeApiSpec(spec map[string]interface{}, info CatalogEntityData, openApi string) (map[string]interface{}, error) {
+	bytes, err := yaml.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	entityInfo := map[string]interface{}{}
+	if err := yaml.Unmarshal(bytes, &entityInfo); err != nil {
+		return nil, err
+	}
+
+	descriptor := map[string]interface{}{}
+	for k, v := range spec {
+		descriptor[k] = v
+	}
+	mergedInfo := map[string]interface{}{}
+	if specInfo, ok := spec["info"].(map[string]interface{}); ok {
+		for k, v := range specInfo {
+			mergedInfo[k] = v
+		}
+	}
+	for k, v := range entityInfo {
+		mergedInfo[k] = v
+	}
+	descriptor["info"] = mergedInfo
+
+	_, hasOpenApi := descriptor["openapi"]
+	_, hasAsyncApi := descriptor["asyncapi"]
+	if !hasOpenApi && !hasAsyncApi {
+		descriptor["openapi"] = openApi
+	}
+	return descriptor, nil
+}
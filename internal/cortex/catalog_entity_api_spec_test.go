@@ -0,0 +1,46 @@
+package cortex_test
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestApiSpecFromDescriptor(t *testing.T) {
+	tests := map[string]struct {
+		descriptor string
+		expected   map[string]interface{}
+	}{
+		"no spec": {
+			descriptor: "openapi: 3.0.1\ninfo:\n  title: Service\n  x-cortex-tag: svc\n",
+			expected:   nil,
+		},
+		"openapi": {
+			descriptor: "openapi: 3.0.1\ninfo:\n  title: Service\n  version: 1.0.0\n  x-cortex-tag: svc\npaths:\n  /a:\n    get:\n      responses:\n        200:\n          description: ok\n",
+			expected: map[string]interface{}{
+				"openapi": "3.0.1",
+				"info":    map[string]interface{}{"version": "1.0.0"},
+				"paths": map[string]interface{}{
+					"/a": map[string]interface{}{"get": map[string]interface{}{"responses": map[string]interface{}{
+						"200": map[string]interface{}{"description": "ok"},
+					}}},
+				},
+			},
+		},
+		"asyncapi": {
+			descriptor: "asyncapi: 2.6.0\ninfo:\n  title: Service\n  x-cortex-tag: svc\nchannels:\n  created: {}\n",
+			expected: map[string]interface{}{
+				"asyncapi": "2.6.0",
+				"channels": map[string]interface{}{"created": map[string]interface{}{}},
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc := map[string]interface{}{}
+			assert.Nil(t, yaml.Unmarshal([]byte(test.descriptor), &doc))
+			assert.Equal(t, test.expected, cortex.ApiSpecFromDescriptor(doc))
+		})
+	}
+}
@@ -2,9 +2,11 @@ package cortex
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/dghubble/sling"
+	"strings"
 )
 
 type CatalogEntityCustomDataClientInterface interface {
@@ -20,14 +22,21 @@ type CatalogEntityCustomDataClient struct {
 
 var _ CatalogEntityCustomDataClientInterface = &CatalogEntityCustomDataClient{}
 
-func (c *CatalogEntityCustomDataClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *CatalogEntityCustomDataClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
 /***********************************************************************************************************************
  * Types
  **********************************************************************************************************************/
 
+const (
+	// CatalogEntityCustomDataSourceApi is the source of custom data set through the custom data endpoints.
+	CatalogEntityCustomDataSourceApi = "API"
+	// CatalogEntityCustomDataSourceYaml is the source of custom data set in an entity's descriptor.
+	CatalogEntityCustomDataSourceYaml = "YAML"
+)
+
 type CatalogEntityCustomData struct {
 	Tag         string      `json:"tag"` // tag of catalog entity
 	Key         string      `json:"key"` // key of custom data
@@ -41,16 +50,19 @@ func (c *CatalogEntityCustomData) ID() string {
 	return c.Tag + ":" + c.Key
 }
 
+// ValueAsString encodes Value as JSON, the inverse of StringToInterface. Strings that StringToInterface would not
+// decode are returned unchanged, so plain text values read back exactly as they were written.
 func (c *CatalogEntityCustomData) ValueAsString() (string, error) {
-	value := ""
-	if c.Value != nil {
-		err := error(nil)
-		value, err = InterfaceToString(c.Value)
-		if err != nil {
-			return "", err
+	if v, ok := c.Value.(string); ok {
+		if _, isJSON := decodeJSONValue(v); !isJSON {
+			return v, nil
 		}
 	}
-	return value, nil
+	value, err := json.Marshal(c.Value)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
 }
 
 /***********************************************************************************************************************
@@ -60,7 +72,7 @@ func (c *CatalogEntityCustomData) ValueAsString() (string, error) {
 func (c *CatalogEntityCustomDataClient) Get(ctx context.Context, entityTag string, key string) (CatalogEntityCustomData, error) {
 	entity := CatalogEntityCustomData{}
 	apiError := ApiError{}
-	response, err := c.Client().Get(Route("catalog_entities", entityTag+"/custom-data/"+key)).Receive(&entity, &apiError)
+	response, err := c.Client(ctx).Get(Route("catalog_entities", entityTag+"/custom-data/"+key)).Receive(&entity, &apiError)
 	if err != nil {
 		return entity, errors.New("could not get catalog entity custom data: " + err.Error())
 	}
@@ -79,14 +91,18 @@ func (c *CatalogEntityCustomDataClient) Get(ctx context.Context, entityTag strin
  **********************************************************************************************************************/
 
 // CatalogEntityCustomDataListParams are the query parameters for the GET /v1/catalog/:tag/custom-data endpoint.
-type CatalogEntityCustomDataListParams struct{}
+type CatalogEntityCustomDataListParams struct {
+	// Source only returns custom data from this source, such as CatalogEntityCustomDataSourceApi. The endpoint cannot
+	// filter by source, so this is applied to its response.
+	Source string `url:"-"`
+}
 
-// List retrieves a list of scorecards based on a query.
+// List retrieves the custom data on a catalog entity.
 func (c *CatalogEntityCustomDataClient) List(ctx context.Context, entityTag string, params CatalogEntityCustomDataListParams) ([]CatalogEntityCustomData, error) {
 	var entities []CatalogEntityCustomData
 	apiError := ApiError{}
 
-	response, err := c.Client().Get(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(entities, &apiError)
+	response, err := c.Client(ctx).Get(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(&entities, &apiError)
 	if err != nil {
 		return nil, errors.New("could not get catalog entity custom data: " + err.Error())
 	}
@@ -96,10 +112,15 @@ func (c *CatalogEntityCustomDataClient) List(ctx context.Context, entityTag stri
 		return nil, err
 	}
 
-	for entitiesIndex := range entities {
-		entities[entitiesIndex].Tag = entityTag
+	filtered := make([]CatalogEntityCustomData, 0, len(entities))
+	for _, entity := range entities {
+		if params.Source != "" && !strings.EqualFold(entity.Source, params.Source) {
+			continue
+		}
+		entity.Tag = entityTag
+		filtered = append(filtered, entity)
 	}
-	return entities, nil
+	return filtered, nil
 }
 
 /***********************************************************************************************************************
@@ -128,7 +149,7 @@ func (c *CatalogEntityCustomDataClient) Upsert(ctx context.Context, entityTag st
 
 	req.Force = true
 
-	body, err := c.Client().Post(Route("catalog_entities", entityTag+"/custom-data")).BodyJSON(&req).Receive(&entity, &apiError)
+	body, err := c.Client(ctx).Post(Route("catalog_entities", entityTag+"/custom-data")).BodyJSON(&req).Receive(&entity, &apiError)
 	if err != nil {
 		return entity, fmt.Errorf("failed upserting custom data for entity: %+v", err)
 	}
@@ -160,7 +181,7 @@ func (c *CatalogEntityCustomDataClient) Delete(ctx context.Context, entityTag st
 		Force: true,
 	}
 
-	body, err := c.Client().Delete(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(&response, &apiError)
+	body, err := c.Client(ctx).Delete(Route("catalog_entities", entityTag+"/custom-data")).QueryStruct(&params).Receive(&response, &apiError)
 	if err != nil {
 		return errors.New("could not delete custom data for catalog entity: " + err.Error())
 	}
@@ -2,6 +2,7 @@ package cortex_test
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -75,3 +76,62 @@ func TestDeleteCatalogEntityCustomData(t *testing.T) {
 	err = c.CatalogEntityCustomData().Delete(context.Background(), tag, key)
 	assert.Nil(t, err, "error deleting catalog entity custom data")
 }
+
+func TestListCatalogEntityCustomData(t *testing.T) {
+	tag := testCatalogCustomDataEntity.Tag
+	resp := []cortex.CatalogEntityCustomData{
+		{Key: "from-api", Source: cortex.CatalogEntityCustomDataSourceApi, Value: map[string]interface{}{"nested": true}},
+		{Key: "from-yaml", Source: cortex.CatalogEntityCustomDataSourceYaml, Value: "yes"},
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", tag+"/custom-data"),
+		resp,
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntityCustomData().List(context.Background(), tag, cortex.CatalogEntityCustomDataListParams{})
+	assert.Nil(t, err, "error listing catalog entity custom data")
+	assert.Len(t, res, 2)
+	assert.Equal(t, tag, res[0].Tag)
+	assert.Equal(t, map[string]interface{}{"nested": true}, res[0].Value)
+	assert.Equal(t, "yes", res[1].Value)
+
+	res, err = c.CatalogEntityCustomData().List(context.Background(), tag, cortex.CatalogEntityCustomDataListParams{Source: "api"})
+	assert.Nil(t, err, "error listing catalog entity custom data")
+	assert.Len(t, res, 1)
+	assert.Equal(t, "from-api", res[0].Key)
+}
+
+func TestCatalogEntityCustomDataValueRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected interface{}
+	}{
+		"plain string":         {input: "us-central1", expected: "us-central1"},
+		"string with braces":   {input: "deploy {env}", expected: "deploy {env}"},
+		"JSON string":          {input: `"123"`, expected: "123"},
+		"empty string":         {input: "", expected: ""},
+		"number":               {input: "3", expected: json.Number("3")},
+		"large integer":        {input: "9007199254740993", expected: json.Number("9007199254740993")},
+		"boolean":              {input: "true", expected: true},
+		"null":                 {input: "null", expected: nil},
+		"array":                {input: `["a",1]`, expected: []interface{}{"a", json.Number("1")}},
+		"object":               {input: `{"a":{"b":false}}`, expected: map[string]interface{}{"a": map[string]interface{}{"b": false}}},
+		"trailing content":     {input: `{"a":1} extra`, expected: `{"a":1} extra`},
+		"multiple JSON values": {input: "1 2", expected: "1 2"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, err := cortex.StringToInterface(test.input)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, value)
+
+			entity := cortex.CatalogEntityCustomData{Value: value}
+			encoded, err := entity.ValueAsString()
+			assert.Nil(t, err)
+			assert.Equal(t, test.input, encoded)
+		})
+	}
+}
@@ -1,316 +1,320 @@
 package cortex
 
-import (
-	"fmt"
-	"reflect"
-)
-
 type CatalogEntityParser struct{}
 
-// YamlToEntity converts YAML into a CatalogEntity, from the specification.
+// YamlToEntity converts YAML into a CatalogEntity, from the specification. Values of the wrong type do not stop the
+// parse; they are skipped, and every one of them is reported in the returned error, qualified by its path in the
+// descriptor.
 func (c *CatalogEntityParser) YamlToEntity(yamlEntity map[string]interface{}) (CatalogEntityData, error) {
 	entity := CatalogEntityData{}
-	info := yamlEntity["info"].(map[string]interface{})
+	r := &descriptorReader{}
 
-	entity.Title = MapFetchToString(info, "title")
-	entity.Description = MapFetchToString(info, "description")
-	entity.Tag = MapFetchToString(info, "x-cortex-tag")
-	entity.Type = MapFetch(info, "x-cortex-type", "service").(string)
+	info, ok := r.mapField(yamlEntity, "", "info")
+	if !ok && yamlEntity["info"] == nil {
+		r.typeError("info", "map", nil)
+	}
+	path := "info"
+
+	entity.Title = r.stringField(info, path, "title")
+	entity.Description = r.stringField(info, path, "description")
+	entity.Tag = r.stringField(info, path, "x-cortex-tag")
+	entity.Type = r.stringField(info, path, "x-cortex-type")
+	if entity.Type == "" {
+		entity.Type = "service"
+	}
 
 	entity.Definition = map[string]interface{}{}
-	if info["x-cortex-definition"] != nil {
-		entity.Definition = info["x-cortex-definition"].(map[string]interface{})
+	if definition, ok := r.mapField(info, path, "x-cortex-definition"); ok {
+		entity.Definition = definition
 	}
 
 	entity.Links = []CatalogEntityLink{}
-	if info["x-cortex-link"] != nil {
-		c.interpolateLinks(&entity, info["x-cortex-link"].([]interface{}))
-	}
+	c.interpolateLinks(r, &entity, info, path)
 
 	entity.Groups = []string{}
-	if info["x-cortex-groups"] != nil {
-		for _, group := range info["x-cortex-groups"].([]interface{}) {
-			entity.Groups = append(entity.Groups, group.(string))
-		}
-	}
+	entity.Groups = append(entity.Groups, r.stringListField(info, path, "x-cortex-groups")...)
 
 	entity.Owners = []CatalogEntityOwner{}
-	if info["x-cortex-owners"] != nil {
-		c.interpolateOwners(&entity, info["x-cortex-owners"].([]interface{}))
-	}
+	c.interpolateOwners(r, &entity, info, path)
 
 	entity.Children = []CatalogEntityChild{}
-	if info["x-cortex-children"] != nil {
-		c.interpolateChildren(&entity, info["x-cortex-children"].([]interface{}))
-	}
+	c.interpolateChildren(r, &entity, info, path)
 
 	entity.Parents = []CatalogEntityParent{}
-	if info["x-cortex-parents"] != nil {
-		c.interpolateParents(&entity, info["x-cortex-parents"].([]interface{}))
-	}
+	c.interpolateParents(r, &entity, info, path)
+
+	c.interpolateRelationships(r, &entity, info, path)
 
 	entity.Metadata = map[string]interface{}{}
-	if info["x-cortex-custom-metadata"] != nil {
-		entity.Metadata = info["x-cortex-custom-metadata"].(map[string]interface{})
+	if metadata, ok := r.mapField(info, path, "x-cortex-custom-metadata"); ok {
+		entity.Metadata = metadata
 	}
 
 	entity.Dependencies = []CatalogEntityDependency{}
-	if info["x-cortex-dependency"] != nil {
-		c.interpolateDependencies(&entity, info["x-cortex-dependency"].([]interface{}))
-	}
+	c.interpolateDependencies(r, &entity, info, path)
+
+	entity.ApiSpec = ApiSpecFromDescriptor(yamlEntity)
 
-	if info["x-cortex-git"] != nil {
+	if gitMap, ok := r.mapField(info, path, "x-cortex-git"); ok {
 		entity.Git = CatalogEntityGit{}
-		c.interpolateGit(&entity, info["x-cortex-git"].(map[string]interface{}))
+		c.interpolateGit(r, &entity, gitMap, fieldPath(path, "x-cortex-git"))
 	}
 
-	if info["x-cortex-dashboards"] != nil {
-		c.interpolateDashboards(&entity, info["x-cortex-dashboards"].(map[string]interface{}))
+	if dashboardsMap, ok := r.mapField(info, path, "x-cortex-dashboards"); ok {
+		c.interpolateDashboards(r, &entity, dashboardsMap, fieldPath(path, "x-cortex-dashboards"))
 	}
 
-	if info["x-cortex-issues"] != nil {
-		c.interpolateIssues(&entity, info["x-cortex-issues"].(map[string]interface{}))
+	if issuesMap, ok := r.mapField(info, path, "x-cortex-issues"); ok {
+		c.interpolateIssues(r, &entity, issuesMap, fieldPath(path, "x-cortex-issues"))
 	}
 
-	if info["x-cortex-slos"] != nil {
-		slosMap := info["x-cortex-slos"].(map[string]interface{})
-		err := c.interpolateSLOs(&entity, slosMap)
-		if err != nil {
-			return entity, err
-		}
+	if slosMap, ok := r.mapField(info, path, "x-cortex-slos"); ok {
+		c.interpolateSLOs(r, &entity, slosMap, fieldPath(path, "x-cortex-slos"))
 	}
 
-	if info["x-cortex-apm"] != nil {
-		c.interpolateApm(&entity, info["x-cortex-apm"].(map[string]interface{}))
+	if apmMap, ok := r.mapField(info, path, "x-cortex-apm"); ok {
+		c.interpolateApm(r, &entity, apmMap, fieldPath(path, "x-cortex-apm"))
 	}
 
-	if info["x-cortex-static-analysis"] != nil {
-		c.interpolateStaticAnalysis(&entity, info["x-cortex-static-analysis"].(map[string]interface{}))
+	if saMap, ok := r.mapField(info, path, "x-cortex-static-analysis"); ok {
+		c.interpolateStaticAnalysis(r, &entity, saMap, fieldPath(path, "x-cortex-static-analysis"))
 	}
 
-	if info["x-cortex-ci-cd"] != nil {
-		c.interpolateCiCd(&entity, info["x-cortex-ci-cd"].(map[string]interface{}))
+	if ciCdMap, ok := r.mapField(info, path, "x-cortex-ci-cd"); ok {
+		c.interpolateCiCd(r, &entity, ciCdMap, fieldPath(path, "x-cortex-ci-cd"))
 	}
 
-	if info["x-cortex-oncall"] != nil {
-		onCallMap := info["x-cortex-oncall"].(map[string]interface{})
-		c.interpolateOnCall(&entity, onCallMap)
+	if onCallMap, ok := r.mapField(info, path, "x-cortex-oncall"); ok {
+		c.interpolateOnCall(r, &entity, onCallMap, fieldPath(path, "x-cortex-oncall"))
 	}
 
-	if info["x-cortex-alerts"] != nil {
-		c.interpolateAlerts(&entity, info["x-cortex-alerts"].([]interface{}))
+	c.interpolateAlerts(r, &entity, info, path)
+
+	if bugSnagMap, ok := r.mapField(info, path, "x-cortex-bugsnag"); ok {
+		c.interpolateBugSnag(r, &entity, bugSnagMap, fieldPath(path, "x-cortex-bugsnag"))
 	}
 
-	if info["x-cortex-bugsnag"] != nil {
-		c.interpolateBugSnag(&entity, info["x-cortex-bugsnag"].(map[string]interface{}))
+	if checkmarxMap, ok := r.mapField(info, path, "x-cortex-checkmarx"); ok {
+		c.interpolateCheckmarx(r, &entity, checkmarxMap, fieldPath(path, "x-cortex-checkmarx"))
 	}
 
-	if info["x-cortex-checkmarx"] != nil {
-		c.interpolateCheckmarx(&entity, info["x-cortex-checkmarx"].(map[string]interface{}))
+	if cciMap, ok := r.mapField(info, path, "x-cortex-circle-ci"); ok {
+		c.interpolateCircleCi(r, &entity, cciMap, fieldPath(path, "x-cortex-circle-ci"))
 	}
 
-	if info["x-cortex-circle-ci"] != nil {
-		c.interpolateCircleCi(&entity, info["x-cortex-circle-ci"].(map[string]interface{}))
+	if coralogixMap, ok := r.mapField(info, path, "x-cortex-coralogix"); ok {
+		c.interpolateCoralogix(r, &entity, coralogixMap, fieldPath(path, "x-cortex-coralogix"))
 	}
 
-	if info["x-cortex-coralogix"] != nil {
-		c.interpolateCoralogix(&entity, info["x-cortex-coralogix"].(map[string]interface{}))
+	if firehydrantMap, ok := r.mapField(info, path, "x-cortex-firehydrant"); ok {
+		c.interpolateFirehydrant(r, &entity, firehydrantMap, fieldPath(path, "x-cortex-firehydrant"))
 	}
 
-	if info["x-cortex-firehydrant"] != nil {
-		c.interpolateFirehydrant(&entity, info["x-cortex-firehydrant"].(map[string]interface{}))
+	if infraMap, ok := r.mapField(info, path, "x-cortex-infra"); ok {
+		c.interpolateInfra(r, &entity, infraMap, fieldPath(path, "x-cortex-infra"))
 	}
 
-	if info["x-cortex-k8s"] != nil {
-		c.interpolateK8s(&entity, info["x-cortex-k8s"].(map[string]interface{}))
+	if azureMap, ok := r.mapField(info, path, "x-cortex-azure"); ok {
+		c.interpolateAzure(r, &entity, azureMap, fieldPath(path, "x-cortex-azure"))
 	}
 
-	if info["x-cortex-launch-darkly"] != nil {
-		c.interpolateLaunchDarkly(&entity, info["x-cortex-launch-darkly"].(map[string]interface{}))
+	if k8sMap, ok := r.mapField(info, path, "x-cortex-k8s"); ok {
+		c.interpolateK8s(r, &entity, k8sMap, fieldPath(path, "x-cortex-k8s"))
 	}
 
-	if info["x-cortex-microsoft-teams"] != nil {
-		c.interpolateMicrosoftTeams(&entity, info["x-cortex-microsoft-teams"].([]interface{}))
+	if ldMap, ok := r.mapField(info, path, "x-cortex-launch-darkly"); ok {
+		c.interpolateLaunchDarkly(r, &entity, ldMap, fieldPath(path, "x-cortex-launch-darkly"))
 	}
 
-	if info["x-cortex-rollbar"] != nil {
-		c.interpolateRollbar(&entity, info["x-cortex-rollbar"].(map[string]interface{}))
+	c.interpolateMicrosoftTeams(r, &entity, info, path)
+
+	if rollbarMap, ok := r.mapField(info, path, "x-cortex-rollbar"); ok {
+		c.interpolateRollbar(r, &entity, rollbarMap, fieldPath(path, "x-cortex-rollbar"))
 	}
 
-	if info["x-cortex-sentry"] != nil {
-		c.interpolateSentry(&entity, info["x-cortex-sentry"].(map[string]interface{}))
+	if sentryMap, ok := r.mapField(info, path, "x-cortex-sentry"); ok {
+		c.interpolateSentry(r, &entity, sentryMap, fieldPath(path, "x-cortex-sentry"))
 	}
 
-	if info["x-cortex-servicenow"] != nil {
-		c.interpolateServiceNow(&entity, info["x-cortex-servicenow"].(map[string]interface{}))
+	if serviceNowMap, ok := r.mapField(info, path, "x-cortex-servicenow"); ok {
+		c.interpolateServiceNow(r, &entity, serviceNowMap, fieldPath(path, "x-cortex-servicenow"))
 	}
 
-	if info["x-cortex-slack"] != nil {
-		c.interpolateSlack(&entity, info["x-cortex-slack"].(map[string]interface{}))
+	if slackMap, ok := r.mapField(info, path, "x-cortex-slack"); ok {
+		c.interpolateSlack(r, &entity, slackMap, fieldPath(path, "x-cortex-slack"))
 	}
 
-	if info["x-cortex-snyk"] != nil {
-		c.interpolateSnyk(&entity, info["x-cortex-snyk"].(map[string]interface{}))
+	if snykMap, ok := r.mapField(info, path, "x-cortex-snyk"); ok {
+		c.interpolateSnyk(r, &entity, snykMap, fieldPath(path, "x-cortex-snyk"))
 	}
 
-	if info["x-cortex-wiz"] != nil {
-		c.interpolateWiz(&entity, info["x-cortex-wiz"].(map[string]interface{}))
+	if wizMap, ok := r.mapField(info, path, "x-cortex-wiz"); ok {
+		c.interpolateWiz(r, &entity, wizMap, fieldPath(path, "x-cortex-wiz"))
 	}
 
 	// team-specific entity attributes
-	if info["x-cortex-team"] != nil {
-		c.interpolateTeam(&entity, info["x-cortex-team"].(map[string]interface{}))
+	if teamMap, ok := r.mapField(info, path, "x-cortex-team"); ok {
+		c.interpolateTeam(r, &entity, teamMap, fieldPath(path, "x-cortex-team"))
 	}
 
-	return entity, nil
+	return entity, r.Err()
 }
 
-func (c *CatalogEntityParser) interpolateLinks(entity *CatalogEntityData, links []interface{}) {
-	for _, link := range links {
-		linkMap := link.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateLinks(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-link", func(linkMap map[string]interface{}, linkPath string) {
 		entity.Links = append(entity.Links, CatalogEntityLink{
-			Name: MapFetchToString(linkMap, "name"),
-			Type: MapFetchToString(linkMap, "type"),
-			Url:  MapFetchToString(linkMap, "url"),
+			Name: r.stringField(linkMap, linkPath, "name"),
+			Type: r.stringField(linkMap, linkPath, "type"),
+			Url:  r.stringField(linkMap, linkPath, "url"),
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateOwners(entity *CatalogEntityData, owners []interface{}) {
-	for _, owner := range owners {
-		ownerMap := owner.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateOwners(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-owners", func(ownerMap map[string]interface{}, ownerPath string) {
 		entity.Owners = append(entity.Owners, CatalogEntityOwner{
-			Type:                 MapFetchToString(ownerMap, "type"),
-			Name:                 MapFetchToString(ownerMap, "name"),
-			Email:                MapFetchToString(ownerMap, "email"),
-			Description:          MapFetchToString(ownerMap, "description"),
-			Provider:             MapFetchToString(ownerMap, "provider"),
-			Channel:              MapFetchToString(ownerMap, "channel"),
-			NotificationsEnabled: MapFetch(ownerMap, "notificationsEnabled", false).(bool),
+			Type:                 r.stringField(ownerMap, ownerPath, "type"),
+			Name:                 r.stringField(ownerMap, ownerPath, "name"),
+			Email:                r.stringField(ownerMap, ownerPath, "email"),
+			Description:          r.stringField(ownerMap, ownerPath, "description"),
+			Provider:             r.stringField(ownerMap, ownerPath, "provider"),
+			Channel:              r.stringField(ownerMap, ownerPath, "channel"),
+			NotificationsEnabled: r.boolField(ownerMap, ownerPath, "notificationsEnabled"),
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateChildren(entity *CatalogEntityData, children []interface{}) {
-	for _, child := range children {
-		childMap := child.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateChildren(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-children", func(childMap map[string]interface{}, childPath string) {
 		entity.Children = append(entity.Children, CatalogEntityChild{
-			Tag: MapFetchToString(childMap, "tag"),
+			Tag: r.stringField(childMap, childPath, "tag"),
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateParents(entity *CatalogEntityData, parents []interface{}) {
-	for _, parent := range parents {
-		parentMap := parent.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateParents(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-parents", func(parentMap map[string]interface{}, parentPath string) {
 		entity.Parents = append(entity.Parents, CatalogEntityParent{
-			Tag: MapFetchToString(parentMap, "tag"),
+			Tag: r.stringField(parentMap, parentPath, "tag"),
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateDependencies(entity *CatalogEntityData, dependencies []interface{}) {
-	for _, dependency := range dependencies {
-		dependencyMap := dependency.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateRelationships(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-relationships", func(relationshipMap map[string]interface{}, relationshipPath string) {
+		relationship := CatalogEntityRelationship{
+			Type: r.stringField(relationshipMap, relationshipPath, "type"),
+		}
+		r.eachMapField(relationshipMap, relationshipPath, "destinations", func(destinationMap map[string]interface{}, destinationPath string) {
+			relationship.Destinations = append(relationship.Destinations, CatalogEntityRelationshipEntity{
+				Tag: r.stringField(destinationMap, destinationPath, "tag"),
+			})
+		})
+		r.eachMapField(relationshipMap, relationshipPath, "sources", func(sourceMap map[string]interface{}, sourcePath string) {
+			relationship.Sources = append(relationship.Sources, CatalogEntityRelationshipEntity{
+				Tag: r.stringField(sourceMap, sourcePath, "tag"),
+			})
+		})
+		entity.Relationships = append(entity.Relationships, relationship)
+	})
+}
+
+func (c *CatalogEntityParser) interpolateDependencies(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-dependency", func(dependencyMap map[string]interface{}, dependencyPath string) {
+		metadata, ok := r.mapField(dependencyMap, dependencyPath, "metadata")
+		if !ok {
+			metadata = map[string]interface{}{}
+		}
 		entity.Dependencies = append(entity.Dependencies, CatalogEntityDependency{
-			Tag:         MapFetchToString(dependencyMap, "tag"),
-			Method:      MapFetchToString(dependencyMap, "method"),
-			Path:        MapFetchToString(dependencyMap, "path"),
-			Description: MapFetchToString(dependencyMap, "description"),
-			Metadata:    MapFetch(dependencyMap, "metadata", map[string]interface{}{}).(map[string]interface{}),
+			Tag:         r.stringField(dependencyMap, dependencyPath, "tag"),
+			Method:      r.stringField(dependencyMap, dependencyPath, "method"),
+			Path:        r.stringField(dependencyMap, dependencyPath, "path"),
+			Description: r.stringField(dependencyMap, dependencyPath, "description"),
+			Metadata:    metadata,
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateDashboards(entity *CatalogEntityData, dashboardsMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateDashboards(r *descriptorReader, entity *CatalogEntityData, dashboardsMap map[string]interface{}, path string) {
 	entity.Dashboards = CatalogEntityDashboards{
 		Embeds: []CatalogEntityDashboardsEmbed{},
 	}
-	if dashboardsMap["embeds"] != nil {
-		embeds := dashboardsMap["embeds"].([]interface{})
-		for _, embed := range embeds {
-			embedMap := embed.(map[string]interface{})
-			entity.Dashboards.Embeds = append(entity.Dashboards.Embeds, CatalogEntityDashboardsEmbed{
-				Type: MapFetchToString(embedMap, "type"),
-				URL:  MapFetchToString(embedMap, "url"),
-			})
-		}
-	}
+	r.eachMapField(dashboardsMap, path, "embeds", func(embedMap map[string]interface{}, embedPath string) {
+		entity.Dashboards.Embeds = append(entity.Dashboards.Embeds, CatalogEntityDashboardsEmbed{
+			Type: r.stringField(embedMap, embedPath, "type"),
+			URL:  r.stringField(embedMap, embedPath, "url"),
+		})
+	})
 }
 
 // OnCall
 
-func (c *CatalogEntityParser) interpolateOnCall(entity *CatalogEntityData, onCallMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateOnCall(r *descriptorReader, entity *CatalogEntityData, onCallMap map[string]interface{}, path string) {
 	entity.OnCall = CatalogEntityOnCall{}
-	if onCallMap["pagerduty"] != nil {
-		pdMap := onCallMap["pagerduty"].(map[string]interface{})
+	if pdMap, ok := r.mapField(onCallMap, path, "pagerduty"); ok {
+		pdPath := fieldPath(path, "pagerduty")
 		entity.OnCall.PagerDuty = CatalogEntityOnCallPagerDuty{
-			ID:   MapFetchToString(pdMap, "id"),
-			Type: MapFetchToString(pdMap, "type"),
+			ID:   r.stringField(pdMap, pdPath, "id"),
+			Type: r.stringField(pdMap, pdPath, "type"),
 		}
 	}
-	if onCallMap["opsgenie"] != nil {
-		ogMap := onCallMap["opsgenie"].(map[string]interface{})
+	if ogMap, ok := r.mapField(onCallMap, path, "opsgenie"); ok {
+		ogPath := fieldPath(path, "opsgenie")
 		entity.OnCall.OpsGenie = CatalogEntityOnCallOpsGenie{
-			ID:   MapFetchToString(ogMap, "id"),
-			Type: MapFetchToString(ogMap, "type"),
+			ID:   r.stringField(ogMap, ogPath, "id"),
+			Type: r.stringField(ogMap, ogPath, "type"),
 		}
 	}
-	if onCallMap["victorops"] != nil {
-		voMap := onCallMap["victorops"].(map[string]interface{})
+	if voMap, ok := r.mapField(onCallMap, path, "victorops"); ok {
+		voPath := fieldPath(path, "victorops")
 		entity.OnCall.VictorOps = CatalogEntityOnCallVictorOps{
-			ID:   MapFetchToString(voMap, "id"),
-			Type: MapFetchToString(voMap, "type"),
+			ID:   r.stringField(voMap, voPath, "id"),
+			Type: r.stringField(voMap, voPath, "type"),
 		}
 	}
-	if onCallMap["xmatters"] != nil {
-		voMap := onCallMap["xmatters"].(map[string]interface{})
+	if xmMap, ok := r.mapField(onCallMap, path, "xmatters"); ok {
+		xmPath := fieldPath(path, "xmatters")
 		entity.OnCall.XMatters = CatalogEntityOnCallXMatters{
-			ID:   MapFetchToString(voMap, "id"),
-			Type: MapFetchToString(voMap, "type"),
+			ID:   r.stringField(xmMap, xmPath, "id"),
+			Type: r.stringField(xmMap, xmPath, "type"),
 		}
 	}
 }
 
 // Git
 
-func (c *CatalogEntityParser) interpolateGit(entity *CatalogEntityData, gitMap map[string]interface{}) {
-	if gitMap["github"] != nil {
-		githubMap := gitMap["github"].(map[string]interface{})
+func (c *CatalogEntityParser) interpolateGit(r *descriptorReader, entity *CatalogEntityData, gitMap map[string]interface{}, path string) {
+	entity.Git.Github = CatalogEntityGitGithub{}
+	if githubMap, ok := r.mapField(gitMap, path, "github"); ok {
+		githubPath := fieldPath(path, "github")
 		entity.Git.Github = CatalogEntityGitGithub{
-			Repository: MapFetchToString(githubMap, "repository"),
-			BasePath:   MapFetchToString(githubMap, "basepath"),
-			Alias:      MapFetchToString(githubMap, "alias"),
+			Repository: r.stringField(githubMap, githubPath, "repository"),
+			BasePath:   r.stringField(githubMap, githubPath, "basepath"),
+			Alias:      r.stringField(githubMap, githubPath, "alias"),
 		}
-	} else {
-		entity.Git.Github = CatalogEntityGitGithub{}
 	}
-	if gitMap["gitlab"] != nil {
-		gitlabMap := gitMap["gitlab"].(map[string]interface{})
+	entity.Git.Gitlab = CatalogEntityGitGitlab{}
+	if gitlabMap, ok := r.mapField(gitMap, path, "gitlab"); ok {
+		gitlabPath := fieldPath(path, "gitlab")
 		entity.Git.Gitlab = CatalogEntityGitGitlab{
-			Repository: MapFetchToString(gitlabMap, "repository"),
-			BasePath:   MapFetchToString(gitlabMap, "basepath"),
+			Repository: r.stringField(gitlabMap, gitlabPath, "repository"),
+			BasePath:   r.stringField(gitlabMap, gitlabPath, "basepath"),
 		}
-	} else {
-		entity.Git.Gitlab = CatalogEntityGitGitlab{}
 	}
-	if gitMap["azure"] != nil {
-		azureMap := gitMap["azure"].(map[string]interface{})
+	entity.Git.Azure = CatalogEntityGitAzureDevOps{}
+	if azureMap, ok := r.mapField(gitMap, path, "azure"); ok {
+		azurePath := fieldPath(path, "azure")
 		entity.Git.Azure = CatalogEntityGitAzureDevOps{
-			Project:    MapFetchToString(azureMap, "project"),
-			Repository: MapFetchToString(azureMap, "repository"),
-			BasePath:   MapFetchToString(azureMap, "basepath"),
+			Project:    r.stringField(azureMap, azurePath, "project"),
+			Repository: r.stringField(azureMap, azurePath, "repository"),
+			BasePath:   r.stringField(azureMap, azurePath, "basepath"),
 		}
-	} else {
-		entity.Git.Azure = CatalogEntityGitAzureDevOps{}
 	}
-	if gitMap["bitbucket"] != nil {
-		bitbucketMap := gitMap["bitbucket"].(map[string]interface{})
+	entity.Git.BitBucket = CatalogEntityGitBitBucket{}
+	if bitbucketMap, ok := r.mapField(gitMap, path, "bitbucket"); ok {
 		entity.Git.BitBucket = CatalogEntityGitBitBucket{
-			Repository: MapFetchToString(bitbucketMap, "repository"),
+			Repository: r.stringField(bitbucketMap, fieldPath(path, "bitbucket"), "repository"),
 		}
-	} else {
-		entity.Git.BitBucket = CatalogEntityGitBitBucket{}
 	}
 }
 
@@ -318,616 +322,525 @@ func (c *CatalogEntityParser) interpolateGit(entity *CatalogEntityData, gitMap m
  * Issues
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateIssues(entity *CatalogEntityData, issuesMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateIssues(r *descriptorReader, entity *CatalogEntityData, issuesMap map[string]interface{}, path string) {
 	entity.Issues = CatalogEntityIssues{}
-	if issuesMap["jira"] != nil {
-		c.interpolateJira(entity, issuesMap["jira"].(map[string]interface{}))
+	if jiraMap, ok := r.mapField(issuesMap, path, "jira"); ok {
+		c.interpolateJira(r, entity, jiraMap, fieldPath(path, "jira"))
 	}
 }
 
 // Jira
 
-func (c *CatalogEntityParser) interpolateJira(entity *CatalogEntityData, jiraMap map[string]interface{}) {
-	if jiraMap["defaultJql"] != nil {
-		jql := MapFetchToString(jiraMap, "defaultJql")
-		if jql != "" {
-			entity.Issues.Jira.DefaultJQL = jql
-		}
-	}
-	if jiraMap["projects"] != nil {
-		projects := jiraMap["projects"].([]interface{})
-		for _, project := range projects {
-			entity.Issues.Jira.Projects = append(entity.Issues.Jira.Projects, project.(string))
-		}
-	}
-	if jiraMap["labels"] != nil {
-		labels := jiraMap["labels"].([]interface{})
-		for _, label := range labels {
-			entity.Issues.Jira.Labels = append(entity.Issues.Jira.Labels, label.(string))
-		}
-	}
-	if jiraMap["components"] != nil {
-		components := jiraMap["components"].([]interface{})
-		for _, component := range components {
-			entity.Issues.Jira.Components = append(entity.Issues.Jira.Components, component.(string))
-		}
+func (c *CatalogEntityParser) interpolateJira(r *descriptorReader, entity *CatalogEntityData, jiraMap map[string]interface{}, path string) {
+	if jql := r.stringField(jiraMap, path, "defaultJql"); jql != "" {
+		entity.Issues.Jira.DefaultJQL = jql
 	}
+	entity.Issues.Jira.Projects = append(entity.Issues.Jira.Projects, r.stringListField(jiraMap, path, "projects")...)
+	entity.Issues.Jira.Labels = append(entity.Issues.Jira.Labels, r.stringListField(jiraMap, path, "labels")...)
+	entity.Issues.Jira.Components = append(entity.Issues.Jira.Components, r.stringListField(jiraMap, path, "components")...)
 }
 
 /***********************************************************************************************************************
  * SLOs
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateSLOs(entity *CatalogEntityData, slosMap map[string]interface{}) error {
+func (c *CatalogEntityParser) interpolateSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs = CatalogEntitySLOs{}
 	if slosMap["datadog"] != nil {
-		c.interpolateDataDogSLOs(entity, slosMap["datadog"].([]interface{}))
+		c.interpolateDataDogSLOs(r, entity, slosMap, path)
 	}
 	if slosMap["dynatrace"] != nil {
-		c.interpolateDynatraceSLOs(entity, slosMap["dynatrace"].([]interface{}))
+		c.interpolateDynatraceSLOs(r, entity, slosMap, path)
 	}
 	if slosMap["lightstep"] != nil {
-		c.interpolateLightstepSLOs(entity, slosMap["lightstep"].([]interface{}))
+		c.interpolateLightstepSLOs(r, entity, slosMap, path)
 	}
 	if slosMap["prometheus"] != nil {
-		err := c.interpolatePrometheusSLOs(entity, slosMap["prometheus"].([]interface{}))
-		if err != nil {
-			return err
-		}
+		c.interpolatePrometheusSLOs(r, entity, slosMap, path)
 	}
 	if slosMap["signalfx"] != nil {
-		c.interpolateSignalFXSLOs(entity, slosMap["signalfx"].([]interface{}))
+		c.interpolateSignalFXSLOs(r, entity, slosMap, path)
 	}
 	if slosMap["sumologic"] != nil {
-		c.interpolateSumoLogicSLOs(entity, slosMap["sumologic"].([]interface{}))
+		c.interpolateSumoLogicSLOs(r, entity, slosMap, path)
 	}
-	return nil
 }
 
 // LightStep
 
-func (c *CatalogEntityParser) interpolateLightstepSLOs(entity *CatalogEntityData, streams []interface{}) {
+func (c *CatalogEntityParser) interpolateLightstepSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
+	streams, _ := slosMap["lightstep"].([]interface{})
 	if len(streams) == 0 {
 		return
 	}
 
-	entity.SLOs.Lightstep = make([]CatalogEntitySLOLightstepStream, len(streams))
-	for i, stream := range streams {
-		streamMap := stream.(map[string]interface{})
+	entity.SLOs.Lightstep = []CatalogEntitySLOLightstepStream{}
+	r.eachMapField(slosMap, path, "lightstep", func(streamMap map[string]interface{}, streamPath string) {
 		streamSLO := CatalogEntitySLOLightstepStream{
-			StreamID: MapFetchToString(streamMap, "streamId"),
+			StreamID: r.stringField(streamMap, streamPath, "streamId"),
 			Targets:  CatalogEntitySLOLightstepTargets{},
 		}
-		if streamMap["targets"] != nil {
-			streamTargetMap := streamMap["targets"].(map[string]interface{})
-			if streamTargetMap["latency"] != nil {
-				latencies := streamTargetMap["latency"].([]interface{})
-				for _, latency := range latencies {
-					latencyMap := latency.(map[string]interface{})
-					streamSLO.Targets.Latencies = append(streamSLO.Targets.Latencies, CatalogEntitySLOLightstepTargetLatency{
-						Percentile: MapFetch(latencyMap, "percentile", 0.0).(float64),
-						Target:     int64(MapFetch(latencyMap, "target", 0).(int)),
-						SLO:        MapFetch(latencyMap, "slo", 0.0).(float64),
-					})
-				}
-			}
+		if targetsMap, ok := r.mapField(streamMap, streamPath, "targets"); ok {
+			r.eachMapField(targetsMap, fieldPath(streamPath, "targets"), "latency", func(latencyMap map[string]interface{}, latencyPath string) {
+				streamSLO.Targets.Latencies = append(streamSLO.Targets.Latencies, CatalogEntitySLOLightstepTargetLatency{
+					Percentile: r.float64Field(latencyMap, latencyPath, "percentile"),
+					Target:     r.int64Field(latencyMap, latencyPath, "target"),
+					SLO:        r.float64Field(latencyMap, latencyPath, "slo"),
+				})
+			})
 		}
-		entity.SLOs.Lightstep[i] = streamSLO
-	}
+		entity.SLOs.Lightstep = append(entity.SLOs.Lightstep, streamSLO)
+	})
 }
 
 // DataDog
 
-func (c *CatalogEntityParser) interpolateDataDogSLOs(entity *CatalogEntityData, slos []interface{}) {
+func (c *CatalogEntityParser) interpolateDataDogSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs.DataDog = []CatalogEntitySLODataDog{}
-	for _, slo := range slos {
-		sloMap := slo.(map[string]interface{})
+	r.eachMapField(slosMap, path, "datadog", func(sloMap map[string]interface{}, sloPath string) {
 		entity.SLOs.DataDog = append(entity.SLOs.DataDog, CatalogEntitySLODataDog{
-			ID: MapFetchToString(sloMap, "id"),
+			ID: r.stringField(sloMap, sloPath, "id"),
 		})
-	}
+	})
 }
 
 // Prometheus
 
-func (c *CatalogEntityParser) interpolatePrometheusSLOs(entity *CatalogEntityData, prometheusQueries []interface{}) error {
+func (c *CatalogEntityParser) interpolatePrometheusSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs.Prometheus = []CatalogEntitySLOPrometheusQuery{}
-	for _, query := range prometheusQueries {
-		queryMap := query.(map[string]interface{})
-		sloVal := MapFetch(queryMap, "slo", 0.0)
-		sloValFloat64, err := AnyToFloat64(sloVal)
-		if err != nil {
-			return fmt.Errorf("error converting SLO value to float64: %+v - %+v - %+v", err, sloVal, reflect.TypeOf(sloVal))
-		}
+	r.eachMapField(slosMap, path, "prometheus", func(queryMap map[string]interface{}, queryPath string) {
 		entity.SLOs.Prometheus = append(entity.SLOs.Prometheus, CatalogEntitySLOPrometheusQuery{
-			ErrorQuery: MapFetchToString(queryMap, "errorQuery"),
-			TotalQuery: MapFetchToString(queryMap, "totalQuery"),
-			Name:       MapFetchToString(queryMap, "name"),
-			Alias:      MapFetchToString(queryMap, "alias"),
-			SLO:        sloValFloat64,
+			ErrorQuery: r.stringField(queryMap, queryPath, "errorQuery"),
+			TotalQuery: r.stringField(queryMap, queryPath, "totalQuery"),
+			Name:       r.stringField(queryMap, queryPath, "name"),
+			Alias:      r.stringField(queryMap, queryPath, "alias"),
+			SLO:        r.numericFloat64Field(queryMap, queryPath, "slo"),
 		})
-	}
-	return nil
+	})
 }
 
 // SignalFX
 
-func (c *CatalogEntityParser) interpolateSignalFXSLOs(entity *CatalogEntityData, signalFxSLOs []interface{}) {
+func (c *CatalogEntityParser) interpolateSignalFXSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs.SignalFX = []CatalogEntitySLOSignalFX{}
-	for _, slo := range signalFxSLOs {
-		sloMap := slo.(map[string]interface{})
+	r.eachMapField(slosMap, path, "signalfx", func(sloMap map[string]interface{}, sloPath string) {
 		entity.SLOs.SignalFX = append(entity.SLOs.SignalFX, CatalogEntitySLOSignalFX{
-			Query:     MapFetchToString(sloMap, "query"),
-			Rollup:    MapFetchToString(sloMap, "rollup"),
-			Target:    int64(MapFetch(sloMap, "target", 0).(int)),
-			Lookback:  MapFetchToString(sloMap, "lookback"),
-			Operation: MapFetchToString(sloMap, "operation"),
+			Query:     r.stringField(sloMap, sloPath, "query"),
+			Rollup:    r.stringField(sloMap, sloPath, "rollup"),
+			Target:    r.int64Field(sloMap, sloPath, "target"),
+			Lookback:  r.stringField(sloMap, sloPath, "lookback"),
+			Operation: r.stringField(sloMap, sloPath, "operation"),
 		})
-	}
+	})
 }
 
 // Dynatrace
 
-func (c *CatalogEntityParser) interpolateDynatraceSLOs(entity *CatalogEntityData, slos []interface{}) {
+func (c *CatalogEntityParser) interpolateDynatraceSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs.Dynatrace = []CatalogEntitySLODynatrace{}
-	for _, slo := range slos {
-		sloMap := slo.(map[string]interface{})
+	r.eachMapField(slosMap, path, "dynatrace", func(sloMap map[string]interface{}, sloPath string) {
 		entity.SLOs.Dynatrace = append(entity.SLOs.Dynatrace, CatalogEntitySLODynatrace{
-			ID: MapFetchToString(sloMap, "id"),
+			ID: r.stringField(sloMap, sloPath, "id"),
 		})
-	}
+	})
 }
 
 // SumoLogic
 
-func (c *CatalogEntityParser) interpolateSumoLogicSLOs(entity *CatalogEntityData, slos []interface{}) {
+func (c *CatalogEntityParser) interpolateSumoLogicSLOs(r *descriptorReader, entity *CatalogEntityData, slosMap map[string]interface{}, path string) {
 	entity.SLOs.SumoLogic = []CatalogEntitySLOSumoLogic{}
-	for _, slo := range slos {
-		sloMap := slo.(map[string]interface{})
+	r.eachMapField(slosMap, path, "sumologic", func(sloMap map[string]interface{}, sloPath string) {
 		entity.SLOs.SumoLogic = append(entity.SLOs.SumoLogic, CatalogEntitySLOSumoLogic{
-			ID: MapFetchToString(sloMap, "id"),
+			ID: r.stringField(sloMap, sloPath, "id"),
 		})
-	}
+	})
 }
 
 /***********************************************************************************************************************
  * APM
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateApm(entity *CatalogEntityData, apm map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateApm(r *descriptorReader, entity *CatalogEntityData, apm map[string]interface{}, path string) {
 	entity.Apm = CatalogEntityApm{}
 
-	if apm["datadog"] != nil {
-		c.interpolateDataDogApm(entity, apm["datadog"].(map[string]interface{}))
+	if ddMap, ok := r.mapField(apm, path, "datadog"); ok {
+		c.interpolateDataDogApm(r, entity, ddMap, fieldPath(path, "datadog"))
 	}
-	if apm["dynatrace"] != nil {
-		c.interpolateDynatraceApm(entity, apm["dynatrace"].(map[string]interface{}))
+	if dtMap, ok := r.mapField(apm, path, "dynatrace"); ok {
+		c.interpolateDynatraceApm(r, entity, dtMap, fieldPath(path, "dynatrace"))
 	}
 	if apm["newrelic"] != nil {
-		c.interpolateNewRelicApm(entity, apm["newrelic"].([]interface{}))
+		c.interpolateNewRelicApm(r, entity, apm, path)
 	}
 }
 
 // DataDog
 
-func (c *CatalogEntityParser) interpolateDataDogApm(entity *CatalogEntityData, apm map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateDataDogApm(r *descriptorReader, entity *CatalogEntityData, apm map[string]interface{}, path string) {
 	entity.Apm.DataDog = CatalogEntityApmDataDog{}
-	if apm["monitors"] != nil {
-		entity.Apm.DataDog.Monitors = make([]int64, len(apm["monitors"].([]interface{})))
-		for i, monitor := range apm["monitors"].([]interface{}) {
-			entity.Apm.DataDog.Monitors[i] = int64(monitor.(int))
+	if monitors, ok := r.listField(apm, path, "monitors"); ok {
+		entity.Apm.DataDog.Monitors = make([]int64, len(monitors))
+		for i, monitor := range monitors {
+			entity.Apm.DataDog.Monitors[i] = r.asInt64(monitor, indexPath(fieldPath(path, "monitors"), i))
 		}
 	}
 }
 
 // Dynatrace
 
-func (c *CatalogEntityParser) interpolateDynatraceApm(entity *CatalogEntityData, apm map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateDynatraceApm(r *descriptorReader, entity *CatalogEntityData, apm map[string]interface{}, path string) {
 	entity.Apm.Dynatrace = CatalogEntityApmDynatrace{}
-	if apm["entityIds"] != nil {
-		entity.Apm.Dynatrace.EntityIDs = make([]string, len(apm["entityIds"].([]interface{})))
-		for i, group := range apm["entityIds"].([]interface{}) {
-			entity.Apm.Dynatrace.EntityIDs[i] = group.(string)
-		}
+	if entityIds := r.stringListField(apm, path, "entityIds"); entityIds != nil {
+		entity.Apm.Dynatrace.EntityIDs = entityIds
 	}
-	if apm["entityNameMatchers"] != nil {
-		entity.Apm.Dynatrace.EntityNameMatchers = make([]string, len(apm["entityNameMatchers"].([]interface{})))
-		for i, group := range apm["entityNameMatchers"].([]interface{}) {
-			entity.Apm.Dynatrace.EntityNameMatchers[i] = group.(string)
-		}
+	if entityNameMatchers := r.stringListField(apm, path, "entityNameMatchers"); entityNameMatchers != nil {
+		entity.Apm.Dynatrace.EntityNameMatchers = entityNameMatchers
 	}
 }
 
 // NewRelic
 
-func (c *CatalogEntityParser) interpolateNewRelicApm(entity *CatalogEntityData, apm []interface{}) {
-	entity.Apm.NewRelic = make([]CatalogEntityApmNewRelic, len(apm))
-	for i, app := range apm {
-		apmMap := app.(map[string]interface{})
-		if apmMap["applicationId"] != nil {
-			entity.Apm.NewRelic[i].ApplicationID = int64(apmMap["applicationId"].(int))
-			if apmMap["alias"] != nil {
-				entity.Apm.NewRelic[i].Alias = apmMap["alias"].(string)
-			}
+func (c *CatalogEntityParser) interpolateNewRelicApm(r *descriptorReader, entity *CatalogEntityData, apm map[string]interface{}, path string) {
+	entity.Apm.NewRelic = []CatalogEntityApmNewRelic{}
+	r.eachMapField(apm, path, "newrelic", func(appMap map[string]interface{}, appPath string) {
+		app := CatalogEntityApmNewRelic{}
+		if appMap["applicationId"] != nil {
+			app.ApplicationID = r.int64Field(appMap, appPath, "applicationId")
+			app.Alias = r.stringField(appMap, appPath, "alias")
 		}
-	}
+		entity.Apm.NewRelic = append(entity.Apm.NewRelic, app)
+	})
 }
 
 /***********************************************************************************************************************
  * Microsoft Teams
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateMicrosoftTeams(entity *CatalogEntityData, teams []interface{}) {
-	for _, team := range teams {
-		teamMap := team.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateMicrosoftTeams(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-microsoft-teams", func(teamMap map[string]interface{}, teamPath string) {
 		entity.MicrosoftTeams = append(entity.MicrosoftTeams, CatalogEntityMicrosoftTeam{
-			Name:                 MapFetchToString(teamMap, "name"),
-			Description:          MapFetchToString(teamMap, "description"),
-			NotificationsEnabled: MapFetch(teamMap, "notificationsEnabled", false).(bool),
+			Name:                 r.stringField(teamMap, teamPath, "name"),
+			Description:          r.stringField(teamMap, teamPath, "description"),
+			NotificationsEnabled: r.boolField(teamMap, teamPath, "notificationsEnabled"),
 		})
-	}
+	})
 }
 
 /***********************************************************************************************************************
  * Sentry
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateSentry(entity *CatalogEntityData, sentryMap map[string]interface{}) {
-	entity.Sentry.Project = MapFetchToString(sentryMap, "project")
+func (c *CatalogEntityParser) interpolateSentry(r *descriptorReader, entity *CatalogEntityData, sentryMap map[string]interface{}, path string) {
+	entity.Sentry.Project = r.stringField(sentryMap, path, "project")
 }
 
 /***********************************************************************************************************************
  * ServiceNow
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateServiceNow(entity *CatalogEntityData, serviceNowMap map[string]interface{}) {
-	if serviceNowMap["services"] != nil {
-		for _, service := range serviceNowMap["services"].([]interface{}) {
-			serviceMap := service.(map[string]interface{})
-			ss := CatalogEntityServiceNowService{}
-			if serviceMap["id"] != nil {
-				ss.ID = MapFetch(serviceMap, "id", 0).(int64)
-			}
-			if serviceMap["tableName"] != nil {
-				ss.TableName = MapFetchToString(serviceMap, "tableName")
-			}
-			if ss.Enabled() {
-				entity.ServiceNow.Services = append(entity.ServiceNow.Services, ss)
-			}
+func (c *CatalogEntityParser) interpolateServiceNow(r *descriptorReader, entity *CatalogEntityData, serviceNowMap map[string]interface{}, path string) {
+	r.eachMapField(serviceNowMap, path, "services", func(serviceMap map[string]interface{}, servicePath string) {
+		ss := CatalogEntityServiceNowService{
+			ID:        r.int64Field(serviceMap, servicePath, "id"),
+			TableName: r.stringField(serviceMap, servicePath, "tableName"),
 		}
-	}
+		if ss.Enabled() {
+			entity.ServiceNow.Services = append(entity.ServiceNow.Services, ss)
+		}
+	})
 }
 
 /***********************************************************************************************************************
  * Rollbar
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateRollbar(entity *CatalogEntityData, rollbarMap map[string]interface{}) {
-	entity.Rollbar.Project = MapFetchToString(rollbarMap, "project")
+func (c *CatalogEntityParser) interpolateRollbar(r *descriptorReader, entity *CatalogEntityData, rollbarMap map[string]interface{}, path string) {
+	entity.Rollbar.Project = r.stringField(rollbarMap, path, "project")
 }
 
 /***********************************************************************************************************************
  * BugSnag
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateBugSnag(entity *CatalogEntityData, bugSnagMap map[string]interface{}) {
-	entity.BugSnag.Project = MapFetchToString(bugSnagMap, "project")
+func (c *CatalogEntityParser) interpolateBugSnag(r *descriptorReader, entity *CatalogEntityData, bugSnagMap map[string]interface{}, path string) {
+	entity.BugSnag.Project = r.stringField(bugSnagMap, path, "project")
 }
 
 /***********************************************************************************************************************
  * Checkmarx
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateCheckmarx(entity *CatalogEntityData, checkmarxMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateCheckmarx(r *descriptorReader, entity *CatalogEntityData, checkmarxMap map[string]interface{}, path string) {
 	entity.Checkmarx = CatalogEntityCheckmarx{
 		Projects: []CatalogEntityCheckmarxProject{},
 	}
-	if checkmarxMap["projects"] != nil {
-		for _, project := range checkmarxMap["projects"].([]interface{}) {
-			projectMap := project.(map[string]interface{})
-			pe := CatalogEntityCheckmarxProject{}
-			if projectMap["projectId"] != nil {
-				pe.ID = MapFetch(projectMap, "projectId", 0).(int64)
-			} else if projectMap["projectName"] != nil {
-				pe.Name = MapFetchToString(projectMap, "projectName")
-			}
-			if pe.ID > 0 || pe.Name != "" {
-				entity.Checkmarx.Projects = append(entity.Checkmarx.Projects, pe)
-			}
+	r.eachMapField(checkmarxMap, path, "projects", func(projectMap map[string]interface{}, projectPath string) {
+		pe := CatalogEntityCheckmarxProject{}
+		if projectMap["projectId"] != nil {
+			pe.ID = r.int64Field(projectMap, projectPath, "projectId")
+		} else {
+			pe.Name = r.stringField(projectMap, projectPath, "projectName")
 		}
-	}
+		if pe.ID > 0 || pe.Name != "" {
+			entity.Checkmarx.Projects = append(entity.Checkmarx.Projects, pe)
+		}
+	})
 }
 
 /***********************************************************************************************************************
- * Checkmarx
+ * Coralogix
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateCoralogix(entity *CatalogEntityData, coralogixMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateCoralogix(r *descriptorReader, entity *CatalogEntityData, coralogixMap map[string]interface{}, path string) {
 	entity.Coralogix = CatalogEntityCoralogix{
 		Applications: []CatalogEntityCoralogixApplication{},
 	}
-	if coralogixMap["applications"] != nil {
-		for _, app := range coralogixMap["applications"].([]interface{}) {
-			appMap := app.(map[string]interface{})
-			coralogixApp := CatalogEntityCoralogixApplication{}
-			coralogixApp.Name = MapFetchToString(appMap, "applicationName")
-			if appMap["alias"] != nil {
-				coralogixApp.Alias = MapFetchToString(appMap, "alias")
-			}
-			entity.Coralogix.Applications = append(entity.Coralogix.Applications, coralogixApp)
-		}
-	}
+	r.eachMapField(coralogixMap, path, "applications", func(appMap map[string]interface{}, appPath string) {
+		entity.Coralogix.Applications = append(entity.Coralogix.Applications, CatalogEntityCoralogixApplication{
+			Name:  r.stringField(appMap, appPath, "applicationName"),
+			Alias: r.stringField(appMap, appPath, "alias"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * Firehydrant
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateFirehydrant(entity *CatalogEntityData, firehydrantMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateFirehydrant(r *descriptorReader, entity *CatalogEntityData, firehydrantMap map[string]interface{}, path string) {
 	entity.FireHydrant = CatalogEntityFireHydrant{
 		Services: []CatalogEntityFireHydrantService{},
 	}
-	if firehydrantMap["services"] != nil {
-		for _, service := range firehydrantMap["services"].([]interface{}) {
-			serviceMap := service.(map[string]interface{})
-			se := CatalogEntityFireHydrantService{}
-			if serviceMap["identifier"] != nil {
-				se.ID = MapFetch(serviceMap, "identifier", "").(string)
-			}
-			if serviceMap["identifierType"] != nil {
-				se.Type = MapFetch(serviceMap, "identifierType", "").(string)
-			}
-			if se.Enabled() {
-				entity.FireHydrant.Services = append(entity.FireHydrant.Services, se)
-			}
+	r.eachMapField(firehydrantMap, path, "services", func(serviceMap map[string]interface{}, servicePath string) {
+		se := CatalogEntityFireHydrantService{
+			ID:   r.stringField(serviceMap, servicePath, "identifier"),
+			Type: r.stringField(serviceMap, servicePath, "identifierType"),
 		}
-	}
+		if se.Enabled() {
+			entity.FireHydrant.Services = append(entity.FireHydrant.Services, se)
+		}
+	})
 }
 
 /***********************************************************************************************************************
- * Kubernetes
+ * Infrastructure
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateK8s(entity *CatalogEntityData, k8sMap map[string]interface{}) {
-	if k8sMap["deployment"] != nil {
-		c.interpolateK8sDeployment(entity, k8sMap["deployment"].([]interface{}))
-	}
-	if k8sMap["argorollout"] != nil {
-		c.interpolateK8sArgoRollout(entity, k8sMap["argorollout"].([]interface{}))
-	}
-	if k8sMap["statefulset"] != nil {
-		c.interpolateK8sStatefulSet(entity, k8sMap["statefulset"].([]interface{}))
+func (c *CatalogEntityParser) interpolateInfra(r *descriptorReader, entity *CatalogEntityData, infraMap map[string]interface{}, path string) {
+	if awsMap, ok := r.mapField(infraMap, path, "aws"); ok {
+		awsPath := fieldPath(path, "aws")
+		r.eachMapField(awsMap, awsPath, "cloudControl", func(resourceMap map[string]interface{}, resourcePath string) {
+			entity.Infra.Aws.CloudControl = append(entity.Infra.Aws.CloudControl, CatalogEntityInfraAwsCloudControl{
+				Type:       r.stringField(resourceMap, resourcePath, "type"),
+				Region:     r.stringField(resourceMap, resourcePath, "region"),
+				AccountID:  r.stringField(resourceMap, resourcePath, "accountId"),
+				Identifier: r.stringField(resourceMap, resourcePath, "identifier"),
+			})
+		})
+		r.eachMapField(awsMap, awsPath, "ecs", func(serviceMap map[string]interface{}, servicePath string) {
+			entity.Infra.Aws.Ecs = append(entity.Infra.Aws.Ecs, CatalogEntityInfraAwsEcs{
+				ClusterArn: r.stringField(serviceMap, servicePath, "clusterArn"),
+				ServiceArn: r.stringField(serviceMap, servicePath, "serviceArn"),
+			})
+		})
 	}
-	if k8sMap["cronjob"] != nil {
-		c.interpolateK8sCronJob(entity, k8sMap["cronjob"].([]interface{}))
+	if gcpMap, ok := r.mapField(infraMap, path, "Google Cloud"); ok {
+		r.eachMapField(gcpMap, fieldPath(path, "Google Cloud"), "resources", func(resourceMap map[string]interface{}, resourcePath string) {
+			entity.Infra.Gcp.Resources = append(entity.Infra.Gcp.Resources, CatalogEntityInfraGcpResource{
+				ResourceName: r.stringField(resourceMap, resourcePath, "resourceName"),
+				ProjectID:    r.stringField(resourceMap, resourcePath, "projectId"),
+				ResourceType: r.stringField(resourceMap, resourcePath, "resourceType"),
+			})
+		})
 	}
 }
 
-func (c *CatalogEntityParser) interpolateK8sDeployment(entity *CatalogEntityData, deployments []interface{}) {
-	for _, deployment := range deployments {
-		deploymentMap := deployment.(map[string]interface{})
-		entity.K8s.Deployments = append(entity.K8s.Deployments, CatalogEntityK8sDeployment{
-			Identifier: MapFetchToString(deploymentMap, "identifier"),
-			Cluster:    MapFetchToString(deploymentMap, "cluster"),
+func (c *CatalogEntityParser) interpolateAzure(r *descriptorReader, entity *CatalogEntityData, azureMap map[string]interface{}, path string) {
+	r.eachMapField(azureMap, path, "ids", func(resourceMap map[string]interface{}, resourcePath string) {
+		entity.Azure.Resources = append(entity.Azure.Resources, CatalogEntityAzureResource{
+			ID:    r.stringField(resourceMap, resourcePath, "id"),
+			Alias: r.stringField(resourceMap, resourcePath, "alias"),
 		})
-	}
+	})
 }
 
-func (c *CatalogEntityParser) interpolateK8sArgoRollout(entity *CatalogEntityData, deployments []interface{}) {
-	for _, deployment := range deployments {
-		deploymentMap := deployment.(map[string]interface{})
+/***********************************************************************************************************************
+ * Kubernetes
+ **********************************************************************************************************************/
+
+func (c *CatalogEntityParser) interpolateK8s(r *descriptorReader, entity *CatalogEntityData, k8sMap map[string]interface{}, path string) {
+	r.eachMapField(k8sMap, path, "deployment", func(deploymentMap map[string]interface{}, deploymentPath string) {
+		entity.K8s.Deployments = append(entity.K8s.Deployments, CatalogEntityK8sDeployment{
+			Identifier: r.stringField(deploymentMap, deploymentPath, "identifier"),
+			Cluster:    r.stringField(deploymentMap, deploymentPath, "cluster"),
+		})
+	})
+	r.eachMapField(k8sMap, path, "argorollout", func(rolloutMap map[string]interface{}, rolloutPath string) {
 		entity.K8s.ArgoRollouts = append(entity.K8s.ArgoRollouts, CatalogEntityK8sArgoRollout{
-			Identifier: MapFetchToString(deploymentMap, "identifier"),
-			Cluster:    MapFetchToString(deploymentMap, "cluster"),
+			Identifier: r.stringField(rolloutMap, rolloutPath, "identifier"),
+			Cluster:    r.stringField(rolloutMap, rolloutPath, "cluster"),
 		})
-	}
-}
-
-func (c *CatalogEntityParser) interpolateK8sStatefulSet(entity *CatalogEntityData, deployments []interface{}) {
-	for _, deployment := range deployments {
-		statefulSetMap := deployment.(map[string]interface{})
+	})
+	r.eachMapField(k8sMap, path, "statefulset", func(statefulSetMap map[string]interface{}, statefulSetPath string) {
 		entity.K8s.StatefulSets = append(entity.K8s.StatefulSets, CatalogEntityK8sStatefulSet{
-			Identifier: MapFetchToString(statefulSetMap, "identifier"),
-			Cluster:    MapFetchToString(statefulSetMap, "cluster"),
+			Identifier: r.stringField(statefulSetMap, statefulSetPath, "identifier"),
+			Cluster:    r.stringField(statefulSetMap, statefulSetPath, "cluster"),
 		})
-	}
-}
-
-func (c *CatalogEntityParser) interpolateK8sCronJob(entity *CatalogEntityData, cronJobs []interface{}) {
-	for _, cronJob := range cronJobs {
-		cronJobMap := cronJob.(map[string]interface{})
+	})
+	r.eachMapField(k8sMap, path, "cronjob", func(cronJobMap map[string]interface{}, cronJobPath string) {
 		entity.K8s.CronJobs = append(entity.K8s.CronJobs, CatalogEntityK8sCronJob{
-			Identifier: MapFetchToString(cronJobMap, "identifier"),
-			Cluster:    MapFetchToString(cronJobMap, "cluster"),
+			Identifier: r.stringField(cronJobMap, cronJobPath, "identifier"),
+			Cluster:    r.stringField(cronJobMap, cronJobPath, "cluster"),
 		})
-	}
+	})
 }
 
 /***********************************************************************************************************************
  * LaunchDarkly
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateLaunchDarkly(entity *CatalogEntityData, ldMap map[string]interface{}) {
-	if ldMap["projects"] != nil {
-		c.interpolateLaunchDarklyProjects(entity, ldMap["projects"].([]interface{}))
-	}
-}
-
-func (c *CatalogEntityParser) interpolateLaunchDarklyProjects(entity *CatalogEntityData, projects []interface{}) {
-	for _, project := range projects {
-		projectMap := project.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateLaunchDarkly(r *descriptorReader, entity *CatalogEntityData, ldMap map[string]interface{}, path string) {
+	r.eachMapField(ldMap, path, "projects", func(projectMap map[string]interface{}, projectPath string) {
 		pe := CatalogEntityLaunchDarklyProject{
-			ID:   MapFetchToString(projectMap, "identifier"),
-			Type: MapFetchToString(projectMap, "identifierType"),
-		}
-		if projectMap["alias"] != nil {
-			pe.Alias = MapFetchToString(projectMap, "alias")
-		}
-
-		if projectMap["environments"] != nil {
-			environments := projectMap["environments"].([]interface{})
-			for _, environment := range environments {
-				environmentMap := environment.(map[string]interface{})
-				pe.Environments = append(pe.Environments, CatalogEntityLaunchDarklyProjectEnvironment{
-					Name: MapFetchToString(environmentMap, "environmentName"),
-				})
-			}
+			ID:    r.stringField(projectMap, projectPath, "identifier"),
+			Type:  r.stringField(projectMap, projectPath, "identifierType"),
+			Alias: r.stringField(projectMap, projectPath, "alias"),
 		}
+		r.eachMapField(projectMap, projectPath, "environments", func(environmentMap map[string]interface{}, environmentPath string) {
+			pe.Environments = append(pe.Environments, CatalogEntityLaunchDarklyProjectEnvironment{
+				Name: r.stringField(environmentMap, environmentPath, "environmentName"),
+			})
+		})
 		entity.LaunchDarkly.Projects = append(entity.LaunchDarkly.Projects, pe)
-	}
+	})
 }
 
 /***********************************************************************************************************************
  * Slack
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateSlack(entity *CatalogEntityData, slackMap map[string]interface{}) {
-	if slackMap["channels"] != nil {
-		channels := slackMap["channels"].([]interface{})
-		for _, channel := range channels {
-			channelMap := channel.(map[string]interface{})
-			entity.Slack.Channels = append(entity.Slack.Channels, CatalogEntitySlackChannel{
-				Name:                 MapFetchToString(channelMap, "name"),
-				NotificationsEnabled: MapFetch(channelMap, "notificationsEnabled", false).(bool),
-			})
-		}
-	}
+func (c *CatalogEntityParser) interpolateSlack(r *descriptorReader, entity *CatalogEntityData, slackMap map[string]interface{}, path string) {
+	r.eachMapField(slackMap, path, "channels", func(channelMap map[string]interface{}, channelPath string) {
+		entity.Slack.Channels = append(entity.Slack.Channels, CatalogEntitySlackChannel{
+			Name:                 r.stringField(channelMap, channelPath, "name"),
+			NotificationsEnabled: r.boolField(channelMap, channelPath, "notificationsEnabled"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * Snyk
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateSnyk(entity *CatalogEntityData, snykMap map[string]interface{}) {
-	if snykMap["projects"] != nil {
-		projects := snykMap["projects"].([]interface{})
-		for _, project := range projects {
-			projectMap := project.(map[string]interface{})
-			entity.Snyk.Projects = append(entity.Snyk.Projects, CatalogEntitySnykProject{
-				ProjectID:    MapFetchToString(projectMap, "projectId"),
-				Organization: MapFetchToString(projectMap, "organizationId"),
-				Source:       MapFetchToString(projectMap, "source"),
-			})
-		}
-	}
+func (c *CatalogEntityParser) interpolateSnyk(r *descriptorReader, entity *CatalogEntityData, snykMap map[string]interface{}, path string) {
+	r.eachMapField(snykMap, path, "projects", func(projectMap map[string]interface{}, projectPath string) {
+		entity.Snyk.Projects = append(entity.Snyk.Projects, CatalogEntitySnykProject{
+			ProjectID:    r.stringField(projectMap, projectPath, "projectId"),
+			Organization: r.stringField(projectMap, projectPath, "organizationId"),
+			Source:       r.stringField(projectMap, projectPath, "source"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * Wiz
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateWiz(entity *CatalogEntityData, wizMap map[string]interface{}) {
-	if wizMap["projects"] != nil {
-		projects := wizMap["projects"].([]interface{})
-		for _, project := range projects {
-			projectMap := project.(map[string]interface{})
-			entity.Wiz.Projects = append(entity.Wiz.Projects, CatalogEntityWizProject{
-				ProjectID: MapFetchToString(projectMap, "projectId"),
-			})
-		}
-	}
+func (c *CatalogEntityParser) interpolateWiz(r *descriptorReader, entity *CatalogEntityData, wizMap map[string]interface{}, path string) {
+	r.eachMapField(wizMap, path, "projects", func(projectMap map[string]interface{}, projectPath string) {
+		entity.Wiz.Projects = append(entity.Wiz.Projects, CatalogEntityWizProject{
+			ProjectID: r.stringField(projectMap, projectPath, "projectId"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * Alerts
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateAlerts(entity *CatalogEntityData, alerts []interface{}) {
-	for _, alert := range alerts {
-		alertMap := alert.(map[string]interface{})
+func (c *CatalogEntityParser) interpolateAlerts(r *descriptorReader, entity *CatalogEntityData, info map[string]interface{}, path string) {
+	r.eachMapField(info, path, "x-cortex-alerts", func(alertMap map[string]interface{}, alertPath string) {
 		entity.Alerts = append(entity.Alerts, CatalogEntityAlert{
-			Type:  MapFetchToString(alertMap, "type"),
-			Tag:   MapFetchToString(alertMap, "tag"),
-			Value: MapFetchToString(alertMap, "value"),
+			Type:  r.stringField(alertMap, alertPath, "type"),
+			Tag:   r.stringField(alertMap, alertPath, "tag"),
+			Value: r.stringField(alertMap, alertPath, "value"),
 		})
-	}
+	})
 }
 
 /***********************************************************************************************************************
  * Static Analysis
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateStaticAnalysis(entity *CatalogEntityData, saMap map[string]interface{}) {
-	if saMap["codecov"] != nil {
-		c.interpolateStaticAnalysisCodeCov(entity, saMap["codecov"].(map[string]interface{}))
+func (c *CatalogEntityParser) interpolateStaticAnalysis(r *descriptorReader, entity *CatalogEntityData, saMap map[string]interface{}, path string) {
+	if ccMap, ok := r.mapField(saMap, path, "codecov"); ok {
+		c.interpolateStaticAnalysisCodeCov(r, entity, ccMap, fieldPath(path, "codecov"))
 	}
-	if saMap["mend"] != nil {
-		c.interpolateStaticAnalysisMend(entity, saMap["mend"].(map[string]interface{}))
+	if mendMap, ok := r.mapField(saMap, path, "mend"); ok {
+		c.interpolateStaticAnalysisMend(r, entity, mendMap, fieldPath(path, "mend"))
 	}
-	if saMap["sonarqube"] != nil {
-		c.interpolateStaticAnalysisSonarQube(entity, saMap["sonarqube"].(map[string]interface{}))
+	if sonarQubeMap, ok := r.mapField(saMap, path, "sonarqube"); ok {
+		c.interpolateStaticAnalysisSonarQube(r, entity, sonarQubeMap, fieldPath(path, "sonarqube"))
 	}
-	if saMap["veracode"] != nil {
-		c.interpolateStaticAnalysisVeracode(entity, saMap["veracode"].(map[string]interface{}))
+	if veracodeMap, ok := r.mapField(saMap, path, "veracode"); ok {
+		c.interpolateStaticAnalysisVeracode(r, entity, veracodeMap, fieldPath(path, "veracode"))
 	}
 }
 
 // CodeCov
 
-func (c *CatalogEntityParser) interpolateStaticAnalysisCodeCov(entity *CatalogEntityData, ccMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateStaticAnalysisCodeCov(r *descriptorReader, entity *CatalogEntityData, ccMap map[string]interface{}, path string) {
 	entity.StaticAnalysis.CodeCov = CatalogEntityStaticAnalysisCodeCov{
-		Repository: MapFetchToString(ccMap, "repo"),
-		Provider:   MapFetchToString(ccMap, "provider"),
-		Owner:      MapFetchToString(ccMap, "owner"),
-		Flag:       MapFetchToString(ccMap, "flag"),
+		Repository: r.stringField(ccMap, path, "repo"),
+		Provider:   r.stringField(ccMap, path, "provider"),
+		Owner:      r.stringField(ccMap, path, "owner"),
+		Flag:       r.stringField(ccMap, path, "flag"),
 	}
 }
 
 // Mend
 
-func (c *CatalogEntityParser) interpolateStaticAnalysisMend(entity *CatalogEntityData, data map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateStaticAnalysisMend(r *descriptorReader, entity *CatalogEntityData, data map[string]interface{}, path string) {
 	entity.StaticAnalysis.Mend = CatalogEntityStaticAnalysisMend{}
-	applicationIds := data["applicationIds"].([]interface{})
-	for _, applicationId := range applicationIds {
-		if applicationId.(string) != "" {
-			entity.StaticAnalysis.Mend.ApplicationIDs = append(entity.StaticAnalysis.Mend.ApplicationIDs, applicationId.(string))
+	for _, applicationId := range r.stringListField(data, path, "applicationIds") {
+		if applicationId != "" {
+			entity.StaticAnalysis.Mend.ApplicationIDs = append(entity.StaticAnalysis.Mend.ApplicationIDs, applicationId)
 		}
 	}
-	projectIds := data["projectIds"].([]interface{})
-	for _, projectId := range projectIds {
-		if projectId.(string) != "" {
-			entity.StaticAnalysis.Mend.ProjectIDs = append(entity.StaticAnalysis.Mend.ProjectIDs, projectId.(string))
+	for _, projectId := range r.stringListField(data, path, "projectIds") {
+		if projectId != "" {
+			entity.StaticAnalysis.Mend.ProjectIDs = append(entity.StaticAnalysis.Mend.ProjectIDs, projectId)
 		}
 	}
 }
 
 // SonarQube
 
-func (c *CatalogEntityParser) interpolateStaticAnalysisSonarQube(entity *CatalogEntityData, data map[string]interface{}) {
-	entity.StaticAnalysis.SonarQube.Project = data["project"].(string)
-	if data["alias"] != nil {
-		entity.StaticAnalysis.SonarQube.Alias = data["alias"].(string)
-	}
+func (c *CatalogEntityParser) interpolateStaticAnalysisSonarQube(r *descriptorReader, entity *CatalogEntityData, data map[string]interface{}, path string) {
+	entity.StaticAnalysis.SonarQube.Project = r.stringField(data, path, "project")
+	entity.StaticAnalysis.SonarQube.Alias = r.stringField(data, path, "alias")
 }
 
 // Veracode
 
-func (c *CatalogEntityParser) interpolateStaticAnalysisVeracode(entity *CatalogEntityData, mendMap map[string]interface{}) {
-	applicationNames := mendMap["applicationNames"].([]interface{})
-	if len(applicationNames) == 0 && mendMap["sandboxes"] == nil {
+func (c *CatalogEntityParser) interpolateStaticAnalysisVeracode(r *descriptorReader, entity *CatalogEntityData, veracodeMap map[string]interface{}, path string) {
+	applicationNames := r.stringListField(veracodeMap, path, "applicationNames")
+	if len(applicationNames) == 0 && veracodeMap["sandboxes"] == nil {
 		return
 	}
 
 	entity.StaticAnalysis.Veracode = CatalogEntityStaticAnalysisVeracode{}
-	for _, applicationName := range applicationNames {
-		entity.StaticAnalysis.Veracode.ApplicationNames = append(entity.StaticAnalysis.Veracode.ApplicationNames, applicationName.(string))
-	}
-	if mendMap["sandboxes"] != nil {
+	entity.StaticAnalysis.Veracode.ApplicationNames = append(entity.StaticAnalysis.Veracode.ApplicationNames, applicationNames...)
+	if veracodeMap["sandboxes"] != nil {
 		entity.StaticAnalysis.Veracode.Sandboxes = []CatalogEntityStaticAnalysisVeracodeSandbox{}
-		sandboxes := mendMap["sandboxes"].([]interface{})
-		for _, sandbox := range sandboxes {
-			sandboxMap := sandbox.(map[string]interface{})
+		r.eachMapField(veracodeMap, path, "sandboxes", func(sandboxMap map[string]interface{}, sandboxPath string) {
 			if sandboxMap["applicationName"] != nil || sandboxMap["sandboxName"] != nil {
-				sandboxEntity := CatalogEntityStaticAnalysisVeracodeSandbox{}
-				if sandboxMap["applicationName"] != nil {
-					sandboxEntity.ApplicationName = sandboxMap["applicationName"].(string)
-				}
-				if sandboxMap["sandboxName"] != nil {
-					sandboxEntity.SandboxName = sandboxMap["sandboxName"].(string)
-				}
-				entity.StaticAnalysis.Veracode.Sandboxes = append(entity.StaticAnalysis.Veracode.Sandboxes, sandboxEntity)
+				entity.StaticAnalysis.Veracode.Sandboxes = append(entity.StaticAnalysis.Veracode.Sandboxes, CatalogEntityStaticAnalysisVeracodeSandbox{
+					ApplicationName: r.stringField(sandboxMap, sandboxPath, "applicationName"),
+					SandboxName:     r.stringField(sandboxMap, sandboxPath, "sandboxName"),
+				})
 			}
-		}
+		})
 	}
 }
 
@@ -935,79 +848,59 @@ func (c *CatalogEntityParser) interpolateStaticAnalysisVeracode(entity *CatalogE
  * CiCd
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateCiCd(entity *CatalogEntityData, ciCdMap map[string]interface{}) {
-	if ciCdMap["buildkite"] != nil {
-		c.interpolateCiCdBuildkite(entity, ciCdMap["buildkite"].(map[string]interface{}))
+func (c *CatalogEntityParser) interpolateCiCd(r *descriptorReader, entity *CatalogEntityData, ciCdMap map[string]interface{}, path string) {
+	if bkMap, ok := r.mapField(ciCdMap, path, "buildkite"); ok {
+		c.interpolateCiCdBuildkite(r, entity, bkMap, fieldPath(path, "buildkite"))
 	}
 }
 
 // Buildkite
 
-func (c *CatalogEntityParser) interpolateCiCdBuildkite(entity *CatalogEntityData, bkMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateCiCdBuildkite(r *descriptorReader, entity *CatalogEntityData, bkMap map[string]interface{}, path string) {
 	entity.CiCd.Buildkite = CatalogEntityCiCdBuildkite{}
-	if bkMap["pipelines"] != nil {
-		pipelines := bkMap["pipelines"].([]interface{})
-		for _, pipeline := range pipelines {
-			pipelineMap := pipeline.(map[string]interface{})
-			entity.CiCd.Buildkite.Pipelines = append(entity.CiCd.Buildkite.Pipelines, CatalogEntityCiCdBuildkitePipeline{
-				Slug: MapFetchToString(pipelineMap, "slug"),
-			})
-		}
-	}
-	if bkMap["tags"] != nil {
-		tags := bkMap["tags"].([]interface{})
-		for _, tag := range tags {
-			tagMap := tag.(map[string]interface{})
-			entity.CiCd.Buildkite.Tags = append(entity.CiCd.Buildkite.Tags, CatalogEntityCiCdBuildkiteTag{
-				Tag: MapFetchToString(tagMap, "tag"),
-			})
-		}
-	}
+	r.eachMapField(bkMap, path, "pipelines", func(pipelineMap map[string]interface{}, pipelinePath string) {
+		entity.CiCd.Buildkite.Pipelines = append(entity.CiCd.Buildkite.Pipelines, CatalogEntityCiCdBuildkitePipeline{
+			Slug: r.stringField(pipelineMap, pipelinePath, "slug"),
+		})
+	})
+	r.eachMapField(bkMap, path, "tags", func(tagMap map[string]interface{}, tagPath string) {
+		entity.CiCd.Buildkite.Tags = append(entity.CiCd.Buildkite.Tags, CatalogEntityCiCdBuildkiteTag{
+			Tag: r.stringField(tagMap, tagPath, "tag"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * CircleCi
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateCircleCi(entity *CatalogEntityData, cciMap map[string]interface{}) {
+func (c *CatalogEntityParser) interpolateCircleCi(r *descriptorReader, entity *CatalogEntityData, cciMap map[string]interface{}, path string) {
 	entity.CircleCi = CatalogEntityCircleCi{}
-	if cciMap["projects"] != nil {
-		projects := cciMap["projects"].([]interface{})
-		for _, project := range projects {
-			projectMap := project.(map[string]interface{})
-			entity.CircleCi.Projects = append(entity.CircleCi.Projects, CatalogEntityCircleCiProject{
-				Slug:  MapFetchToString(projectMap, "projectSlug"),
-				Alias: MapFetchToString(projectMap, "alias"),
-			})
-		}
-	}
+	r.eachMapField(cciMap, path, "projects", func(projectMap map[string]interface{}, projectPath string) {
+		entity.CircleCi.Projects = append(entity.CircleCi.Projects, CatalogEntityCircleCiProject{
+			Slug:  r.stringField(projectMap, projectPath, "projectSlug"),
+			Alias: r.stringField(projectMap, projectPath, "alias"),
+		})
+	})
 }
 
 /***********************************************************************************************************************
  * Team attributes
  **********************************************************************************************************************/
 
-func (c *CatalogEntityParser) interpolateTeam(entity *CatalogEntityData, teamMap map[string]interface{}) {
-	if teamMap["members"] != nil {
-		members := teamMap["members"].([]interface{})
-		for _, member := range members {
-			memberMap := member.(map[string]interface{})
-			entity.Team.Members = append(entity.Team.Members, CatalogEntityTeamMember{
-				Name:                 MapFetchToString(memberMap, "name"),
-				Email:                MapFetchToString(memberMap, "email"),
-				Role:                 MapFetchToString(memberMap, "role"),
-				NotificationsEnabled: MapFetch(memberMap, "notificationsEnabled", false).(bool),
-			})
-		}
-	}
-	if teamMap["groups"] != nil {
-		groups := teamMap["groups"].([]interface{})
-		for _, group := range groups {
-			groupMap := group.(map[string]interface{})
-			entity.Team.Groups = append(entity.Team.Groups, CatalogEntityGroupMember{
-				Name:     MapFetchToString(groupMap, "name"),
-				Provider: MapFetchToString(groupMap, "provider"),
-			})
-		}
-	}
+func (c *CatalogEntityParser) interpolateTeam(r *descriptorReader, entity *CatalogEntityData, teamMap map[string]interface{}, path string) {
+	r.eachMapField(teamMap, path, "members", func(memberMap map[string]interface{}, memberPath string) {
+		entity.Team.Members = append(entity.Team.Members, CatalogEntityTeamMember{
+			Name:                 r.stringField(memberMap, memberPath, "name"),
+			Email:                r.stringField(memberMap, memberPath, "email"),
+			Role:                 r.stringField(memberMap, memberPath, "role"),
+			NotificationsEnabled: r.boolField(memberMap, memberPath, "notificationsEnabled"),
+		})
+	})
+	r.eachMapField(teamMap, path, "groups", func(groupMap map[string]interface{}, groupPath string) {
+		entity.Team.Groups = append(entity.Team.Groups, CatalogEntityGroupMember{
+			Name:     r.stringField(groupMap, groupPath, "name"),
+			Provider: r.stringField(groupMap, groupPath, "provider"),
+		})
+	})
 }
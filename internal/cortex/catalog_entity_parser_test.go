@@ -0,0 +1,140 @@
+package cortex_test
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+const testCatalogEntityDescriptor = `
+openapi: 3.0.1
+info:
+  title: Parser Test Service
+  x-cortex-tag: parser-test
+  x-cortex-type: service
+  x-cortex-groups: [a, b]
+  x-cortex-owners:
+    - type: EMAIL
+      email: owner@example.com
+      notificationsEnabled: true
+  x-cortex-oncall:
+    pagerduty:
+      id: P123
+      type: SERVICE
+  x-cortex-slos:
+    lightstep:
+      - streamId: abc
+        targets:
+          latency:
+            - percentile: 0.5
+              target: 2
+              slo: 0.9995
+    prometheus:
+      - errorQuery: errors
+        totalQuery: total
+        slo: 99
+  x-cortex-apm:
+    datadog:
+      monitors: [1, 2]
+    newrelic:
+      - applicationId: 123
+        alias: default
+  x-cortex-servicenow:
+    services:
+      - id: 42
+        tableName: cmdb_ci_service
+  x-cortex-checkmarx:
+    projects:
+      - projectId: 7
+  x-cortex-static-analysis:
+    mend:
+      applicationIds: [app]
+    sonarqube:
+      project: sonar
+`
+
+func parseTestDescriptor(t *testing.T, descriptor string) (cortex.CatalogEntityData, error) {
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(descriptor), &doc); err != nil {
+		t.Fatalf("could not unmarshal descriptor: %v", err)
+	}
+	return (&cortex.CatalogEntityParser{}).YamlToEntity(doc)
+}
+
+func TestYamlToEntity(t *testing.T) {
+	entity, err := parseTestDescriptor(t, testCatalogEntityDescriptor)
+	assert.Nil(t, err, "could not parse descriptor")
+	assert.Equal(t, "parser-test", entity.Tag)
+	assert.Equal(t, []string{"a", "b"}, entity.Groups)
+	assert.True(t, entity.Owners[0].NotificationsEnabled)
+	assert.Equal(t, "P123", entity.OnCall.PagerDuty.ID)
+	assert.Equal(t, int64(2), entity.SLOs.Lightstep[0].Targets.Latencies[0].Target)
+	assert.Equal(t, 99.0, entity.SLOs.Prometheus[0].SLO)
+	assert.Equal(t, []int64{1, 2}, entity.Apm.DataDog.Monitors)
+	assert.Equal(t, int64(123), entity.Apm.NewRelic[0].ApplicationID)
+	assert.Equal(t, int64(42), entity.ServiceNow.Services[0].ID)
+	assert.Equal(t, int64(7), entity.Checkmarx.Projects[0].ID)
+	assert.Equal(t, []string{"app"}, entity.StaticAnalysis.Mend.ApplicationIDs)
+	assert.Equal(t, "sonar", entity.StaticAnalysis.SonarQube.Project)
+}
+
+func TestYamlToEntityReportsEveryInvalidValue(t *testing.T) {
+	entity, err := parseTestDescriptor(t, `
+info:
+  title: Parser Test Service
+  x-cortex-tag: parser-test
+  x-cortex-groups: [a, 1]
+  x-cortex-link: not-a-list
+  x-cortex-oncall:
+    pagerduty:
+      id: 123
+  x-cortex-apm:
+    datadog:
+      monitors: [1, one]
+  x-cortex-slack:
+    channels:
+      - name: eng
+        notificationsEnabled: "yes"
+  x-cortex-slos:
+    prometheus:
+      - errorQuery: errors
+        totalQuery: total
+        slo: high
+`)
+	assert.ErrorContains(t, err, "info.x-cortex-oncall.pagerduty.id: expected string, got int")
+	assert.ErrorContains(t, err, "info.x-cortex-groups[1]: expected string, got int")
+	assert.ErrorContains(t, err, "info.x-cortex-link: expected list, got string")
+	assert.ErrorContains(t, err, "info.x-cortex-apm.datadog.monitors[1]: expected int, got string")
+	assert.ErrorContains(t, err, "info.x-cortex-slack.channels[0].notificationsEnabled: expected bool, got string")
+	assert.ErrorContains(t, err, "info.x-cortex-slos.prometheus[0].slo: expected float, got string")
+
+	// Valid values are still parsed.
+	assert.Equal(t, "parser-test", entity.Tag)
+	assert.Equal(t, []string{"a"}, entity.Groups)
+	assert.Equal(t, "eng", entity.Slack.Channels[0].Name)
+}
+
+func TestYamlToEntityRequiresInfo(t *testing.T) {
+	_, err := parseTestDescriptor(t, "openapi: 3.0.1\n")
+	assert.ErrorContains(t, err, "info: expected map, got null")
+
+	_, err = parseTestDescriptor(t, "info: [title]\n")
+	assert.ErrorContains(t, err, "info: expected map, got list")
+}
+
+func FuzzYamlToEntity(f *testing.F) {
+	f.Add(testCatalogEntityDescriptor)
+	f.Add("info: {}")
+	f.Add("info:\n  x-cortex-slos:\n    lightstep: [{targets: {latency: [1]}}]")
+	f.Add("info:\n  x-cortex-static-analysis:\n    veracode: {sandboxes: [~]}")
+
+	f.Fuzz(func(t *testing.T, descriptor string) {
+		doc := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(descriptor), &doc); err != nil {
+			t.Skip()
+		}
+		// Any descriptor must parse or fail with an error, never panic.
+		_, _ = (&cortex.CatalogEntityParser{}).YamlToEntity(doc)
+	})
+}
@@ -0,0 +1,158 @@
+package cortex_test
+
+import (
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// TestCatalogEntityDescriptorRoundTrip parses every descriptor in testdata/descriptors, marshals the result the way
+// Upsert sends it to Cortex, and parses that again. Anything in the source descriptor that does not survive marshalling,
+// or that parses differently the second time, is reported by its path in the descriptor, since either one shows up as a
+// perpetual diff in Terraform.
+func TestCatalogEntityDescriptorRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "descriptors", "*.yaml"))
+	assert.Nil(t, err, "could not list descriptors")
+	assert.NotEmpty(t, paths, "no descriptors found")
+
+	parser := &cortex.CatalogEntityParser{}
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".yaml"), func(t *testing.T) {
+			source := readTestDescriptor(t, path)
+			entity, err := parser.YamlToEntity(source)
+			if !assert.Nil(t, err, "could not parse %s", path) {
+				return
+			}
+
+			b, err := yaml.Marshal(cortex.UpsertCatalogEntityRequest{OpenApi: "3.0.1", Info: entity})
+			if !assert.Nil(t, err, "could not marshal %s", path) {
+				return
+			}
+			marshalled := map[string]interface{}{}
+			if !assert.Nil(t, yaml.Unmarshal(b, &marshalled), "could not unmarshal marshalled %s", path) {
+				return
+			}
+			for _, problem := range descriptorLosses("", source, marshalled) {
+				t.Error(problem)
+			}
+
+			reparsed, err := parser.YamlToEntity(marshalled)
+			if !assert.Nil(t, err, "could not parse marshalled %s:\n%s", path, b) {
+				return
+			}
+			for _, problem := range entityDifferences("info", reflect.ValueOf(entity), reflect.ValueOf(reparsed)) {
+				t.Error(problem)
+			}
+		})
+	}
+}
+
+func readTestDescriptor(t *testing.T, path string) map[string]interface{} {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read %s: %v", path, err)
+	}
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		t.Fatalf("could not unmarshal %s: %v", path, err)
+	}
+	return doc
+}
+
+// descriptorLosses reports values in the source descriptor that are missing or different in the marshalled one. Empty
+// and false values, and maps holding only those, are skipped, since omitting them does not change the entity.
+func descriptorLosses(path string, source interface{}, marshalled interface{}) []string {
+	var problems []string
+	switch s := source.(type) {
+	case map[string]interface{}:
+		m, _ := marshalled.(map[string]interface{})
+		keys := make([]string, 0, len(s))
+		for k := range s {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			keyPath := k
+			if path != "" {
+				keyPath = path + "." + k
+			}
+			if isEmptyDescriptorValue(s[k]) {
+				continue
+			}
+			v, ok := m[k]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: lost when marshalling", keyPath))
+				continue
+			}
+			problems = append(problems, descriptorLosses(keyPath, s[k], v)...)
+		}
+	case []interface{}:
+		m, _ := marshalled.([]interface{})
+		if len(s) != len(m) {
+			return append(problems, fmt.Sprintf("%s: had %d items, marshalled %d", path, len(s), len(m)))
+		}
+		for i := range s {
+			problems = append(problems, descriptorLosses(fmt.Sprintf("%s[%d]", path, i), s[i], m[i])...)
+		}
+	default:
+		if fmt.Sprint(source) != fmt.Sprint(marshalled) {
+			problems = append(problems, fmt.Sprintf("%s: was %v, marshalled as %v", path, source, marshalled))
+		}
+	}
+	return problems
+}
+
+func isEmptyDescriptorValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, item := range v {
+			if !isEmptyDescriptorValue(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// entityDifferences reports every field, by its descriptor path, that differs between two parsed entities.
+func entityDifferences(path string, before reflect.Value, after reflect.Value) []string {
+	var problems []string
+	switch before.Kind() {
+	case reflect.Struct:
+		for i := 0; i < before.NumField(); i++ {
+			name := strings.Split(before.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			problems = append(problems, entityDifferences(path+"."+name, before.Field(i), after.Field(i))...)
+		}
+	case reflect.Slice:
+		if before.Len() != after.Len() {
+			return append(problems, fmt.Sprintf("%s: had %d items, has %d after round trip", path, before.Len(), after.Len()))
+		}
+		for i := 0; i < before.Len(); i++ {
+			problems = append(problems, entityDifferences(fmt.Sprintf("%s[%d]", path, i), before.Index(i), after.Index(i))...)
+		}
+	default:
+		if !reflect.DeepEqual(before.Interface(), after.Interface()) {
+			problems = append(problems, fmt.Sprintf("%s: was %v, is %v after round trip", path, before.Interface(), after.Interface()))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,268 @@
+package cortextest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CassetteModeEnvVar is the environment variable that selects whether tests record or replay cassettes.
+const CassetteModeEnvVar = "CORTEX_CASSETTE_MODE"
+
+type CassetteMode string
+
+const (
+	CassetteModeDisabled CassetteMode = ""
+	CassetteModeRecord   CassetteMode = "record"
+	CassetteModeReplay   CassetteMode = "replay"
+)
+
+// CassetteModeFromEnv returns the cassette mode set in CORTEX_CASSETTE_MODE.
+func CassetteModeFromEnv() (CassetteMode, error) {
+	mode := CassetteMode(os.Getenv(CassetteModeEnvVar))
+	switch mode {
+	case CassetteModeDisabled, CassetteModeRecord, CassetteModeReplay:
+		return mode, nil
+	}
+	return mode, fmt.Errorf("%s must be %q or %q, got %q", CassetteModeEnvVar, CassetteModeRecord, CassetteModeReplay, mode)
+}
+
+const redactedSecret = "REDACTED"
+
+// headers that are never written to a cassette.
+var unrecordedHeaders = map[string]bool{
+	"Authorization":       true,
+	"Cookie":              true,
+	"Proxy-Authorization": true,
+	"Set-Cookie":          true,
+	"User-Agent":          true,
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Cassette is a recorded sequence of HTTP interactions with the Cortex API.
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+}
+
+type Interaction struct {
+	Request  CassetteRequest  `yaml:"request"`
+	Response CassetteResponse `yaml:"response"`
+}
+
+type CassetteRequest struct {
+	Method  string            `yaml:"method"`
+	URL     string            `yaml:"url"` // path and query only, so cassettes do not depend on the tenant's host
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	Status  int               `yaml:"status"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty"`
+}
+
+// LoadCassette reads a cassette file written by a Recorder.
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := yaml.Unmarshal(b, cassette); err != nil {
+		return nil, fmt.Errorf("could not parse cassette %s: %w", path, err)
+	}
+	return cassette, nil
+}
+
+// Save writes the cassette to path, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
+
+/***********************************************************************************************************************
+ * Recording
+ **********************************************************************************************************************/
+
+// Recorder is an http.RoundTripper that sends requests through another transport and records every interaction.
+// Secrets, such as the API token, are replaced with REDACTED before anything is written.
+type Recorder struct {
+	next     http.RoundTripper
+	secrets  []string
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder returns a Recorder that sends requests through next, or http.DefaultTransport when next is nil.
+func NewRecorder(next http.RoundTripper, secrets ...string) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	var nonEmpty []string
+	for _, secret := range secrets {
+		if secret != "" {
+			nonEmpty = append(nonEmpty, secret)
+		}
+	}
+	return &Recorder{next: next, secrets: nonEmpty}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: CassetteRequest{
+			Method:  req.Method,
+			URL:     r.scrub(req.URL.RequestURI()),
+			Headers: r.recordHeaders(req.Header),
+			Body:    r.scrub(reqBody),
+		},
+		Response: CassetteResponse{
+			Status:  response.StatusCode,
+			Headers: r.recordHeaders(response.Header),
+			Body:    r.scrub(string(respBody)),
+		},
+	})
+	return response, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &Cassette{Interactions: append([]Interaction{}, r.cassette.Interactions...)}
+}
+
+// Save writes the interactions recorded so far to path.
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+func (r *Recorder) scrub(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redactedSecret)
+	}
+	return s
+}
+
+func (r *Recorder) recordHeaders(headers http.Header) map[string]string {
+	recorded := map[string]string{}
+	for k, v := range headers {
+		if unrecordedHeaders[http.CanonicalHeaderKey(k)] {
+			continue
+		}
+		recorded[k] = r.scrub(strings.Join(v, ", "))
+	}
+	return recorded
+}
+
+/***********************************************************************************************************************
+ * Replaying
+ **********************************************************************************************************************/
+
+// Replayer is an http.RoundTripper that answers requests from a cassette without touching the network. Each recorded
+// interaction is used once, in order, matched on method and URL, preferring an interaction with the same body.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+var _ http.RoundTripper = &Replayer{}
+
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{cassette: cassette, used: make([]bool, len(cassette.Interactions))}
+}
+
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Request.Method != req.Method || interaction.Request.URL != req.URL.RequestURI() {
+			continue
+		}
+		if interaction.Request.Body == body {
+			match = i
+			break
+		}
+		if match == -1 {
+			match = i
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+	}
+	r.used[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	response := &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+	for k, v := range recorded.Headers {
+		response.Header.Set(k, v)
+	}
+	return response, nil
+}
+
+// readRequestBody returns the request body, leaving the request able to send it again.
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+	b, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return string(b), nil
+}
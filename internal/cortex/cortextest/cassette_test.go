@@ -0,0 +1,65 @@
+package cortextest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex/cortextest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndReplayCassette(t *testing.T) {
+	ctx := context.Background()
+	cassettePath := filepath.Join(t.TempDir(), "cassette.yaml")
+
+	// Record a create and a read against the fake server.
+	server := cortextest.NewServer()
+	recorder := cortextest.NewRecorder(nil, cortextest.Token)
+	c, err := cortex.NewClient(
+		cortex.WithURL(server.URL),
+		cortex.WithToken(cortextest.Token),
+		cortex.WithVersion("test"),
+		cortex.WithTransport(recorder),
+	)
+	assert.Nil(t, err, "could not build recording client")
+
+	_, err = c.Teams().Get(ctx, "test-team")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+	_, err = c.Teams().Create(ctx, cortex.CreateTeamRequest{TeamTag: "test-team", Metadata: cortex.TeamMetadata{Name: "Test Team"}})
+	assert.Nil(t, err, "could not create team")
+	_, err = c.Teams().Get(ctx, "test-team")
+	assert.Nil(t, err, "could not get team")
+	assert.Nil(t, recorder.Save(cassettePath), "could not save cassette")
+	server.Close()
+
+	raw, err := os.ReadFile(cassettePath)
+	assert.Nil(t, err, "could not read cassette")
+	assert.NotContains(t, string(raw), cortextest.Token, "expected the token to be scrubbed from the cassette")
+	assert.NotContains(t, string(raw), server.URL, "expected the host to be left out of the cassette")
+
+	// Replay the same calls with the server gone.
+	cassette, err := cortextest.LoadCassette(cassettePath)
+	assert.Nil(t, err, "could not load cassette")
+	assert.Len(t, cassette.Interactions, 3)
+	c, err = cortex.NewClient(
+		cortex.WithURL("https://cortex.invalid"),
+		cortex.WithToken("replay"),
+		cortex.WithVersion("test"),
+		cortex.WithTransport(cortextest.NewReplayer(cassette)),
+	)
+	assert.Nil(t, err, "could not build replaying client")
+
+	_, err = c.Teams().Get(ctx, "test-team")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+	_, err = c.Teams().Create(ctx, cortex.CreateTeamRequest{TeamTag: "test-team", Metadata: cortex.TeamMetadata{Name: "Test Team"}})
+	assert.Nil(t, err, "could not replay team creation")
+	team, err := c.Teams().Get(ctx, "test-team")
+	assert.Nil(t, err, "could not replay team read")
+	assert.Equal(t, "Test Team", team.Metadata.Name)
+
+	_, err = c.Teams().Get(ctx, "test-team")
+	assert.ErrorContains(t, err, "no recorded interaction")
+}
@@ -0,0 +1,193 @@
+package cortextest
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedCatalogEntity stores an OpenAPI descriptor as if it had been upserted through POST /api/v1/open-api.
+func (s *Server) SeedCatalogEntity(descriptor string) error {
+	doc, err := unmarshalYAML(descriptor)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.storeCatalogEntity(doc)
+	return err
+}
+
+func (s *Server) storeCatalogEntity(doc map[string]interface{}) (string, error) {
+	entity, err := (&cortex.CatalogEntityParser{}).YamlToEntity(doc)
+	if err != nil {
+		return "", err
+	}
+	if entity.Tag == "" {
+		return "", errors.New("info.x-cortex-tag is required")
+	}
+	if entity.Title == "" {
+		return "", errors.New("info.title is required")
+	}
+	s.entities[entity.Tag] = doc
+	return entity.Tag, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/open-api
+ **********************************************************************************************************************/
+
+func (s *Server) upsertCatalogEntity(w http.ResponseWriter, req *http.Request, _ []string) {
+	doc, err := decodeYAML(req)
+	if err != nil {
+		s.writeBadRequest(w, "could not parse descriptor: "+err.Error())
+		return
+	}
+	if _, ok := doc["info"].(map[string]interface{}); !ok {
+		s.writeBadRequest(w, "descriptor has no info block")
+		return
+	}
+	if _, err := s.storeCatalogEntity(doc); err != nil {
+		s.writeBadRequest(w, err.Error())
+		return
+	}
+	s.writeJSON(w, http.StatusOK, cortex.UpsertCatalogEntityResponse{
+		Ok:         true,
+		Violations: []cortex.CatalogEntityViolation{},
+	})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog
+ **********************************************************************************************************************/
+
+func (s *Server) listCatalogEntities(w http.ResponseWriter, req *http.Request, _ []string) {
+	types := queryValues(req, "types")
+	groups := queryValues(req, "groups")
+	owners := queryValues(req, "owners")
+	query := strings.ToLower(req.URL.Query().Get("query"))
+
+	entities := []cortex.CatalogEntity{}
+	for _, tag := range sortedKeys(s.entities) {
+		entity := s.catalogEntity(tag)
+		if len(types) > 0 && !slices.Contains(types, entity.Type) {
+			continue
+		}
+		if len(groups) > 0 && !slices.ContainsFunc(entity.Groups, func(g string) bool { return slices.Contains(groups, g) }) {
+			continue
+		}
+		if len(owners) > 0 && !slices.ContainsFunc(entity.Ownership.Groups, func(g cortex.CatalogEntityGroup) bool { return slices.Contains(owners, g.GroupName) }) {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(entity.Tag+" "+entity.Title+" "+entity.Description), query) {
+			continue
+		}
+		entities = append(entities, entity)
+	}
+
+	page, pageSize, err := pageParams(req)
+	if err != nil {
+		s.writeBadRequest(w, err.Error())
+		return
+	}
+	items, totalPages := paginate(entities, page, pageSize)
+	s.writeJSON(w, http.StatusOK, cortex.CatalogEntitiesResponse{
+		Entities:   items,
+		Page:       page,
+		Total:      len(entities),
+		TotalPages: totalPages,
+	})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) getCatalogEntity(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, s.catalogEntity(params[0]))
+}
+
+// catalogEntity renders a stored descriptor in the shape returned by the JSON catalog endpoints.
+func (s *Server) catalogEntity(tag string) cortex.CatalogEntity {
+	data, _ := (&cortex.CatalogEntityParser{}).YamlToEntity(s.entities[tag])
+	entity := cortex.CatalogEntity{
+		Tag:          data.Tag,
+		Title:        data.Title,
+		Description:  data.Description,
+		Type:         data.Type,
+		Groups:       data.Groups,
+		Links:        data.Links,
+		Metadata:     data.Metadata,
+		Dependencies: []string{},
+		Ownership: cortex.CatalogEntityOwnership{
+			Emails:        []cortex.CatalogEntityEmail{},
+			Groups:        []cortex.CatalogEntityGroup{},
+			SlackChannels: []cortex.CatalogEntityOwnershipSlackChannel{},
+		},
+	}
+	for _, dependency := range data.Dependencies {
+		entity.Dependencies = append(entity.Dependencies, dependency.Tag)
+	}
+	for _, owner := range data.Owners {
+		switch strings.ToUpper(owner.Type) {
+		case "EMAIL":
+			entity.Ownership.Emails = append(entity.Ownership.Emails, cortex.CatalogEntityEmail{
+				Email:       owner.Email,
+				Description: owner.Description,
+			})
+		case "GROUP":
+			entity.Ownership.Groups = append(entity.Ownership.Groups, cortex.CatalogEntityGroup{
+				GroupName:   owner.Name,
+				Description: owner.Description,
+				Provider:    owner.Provider,
+			})
+		case "SLACK":
+			entity.Ownership.SlackChannels = append(entity.Ownership.SlackChannels, cortex.CatalogEntityOwnershipSlackChannel{
+				Channel:              owner.Channel,
+				Description:          owner.Description,
+				NotificationsEnabled: owner.NotificationsEnabled,
+			})
+		}
+	}
+	return entity
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/openapi
+ **********************************************************************************************************************/
+
+func (s *Server) getCatalogEntityDescriptor(w http.ResponseWriter, _ *http.Request, params []string) {
+	doc, ok := s.entities[params[0]]
+	if !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	s.writeYAML(w, http.StatusOK, doc)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) deleteCatalogEntity(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	delete(s.entities, params[0])
+	delete(s.customData, params[0])
+	delete(s.packages, params[0])
+	s.deleteEntityDependencies(params[0])
+	w.WriteHeader(http.StatusOK)
+}
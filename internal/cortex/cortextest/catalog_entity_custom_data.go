@@ -0,0 +1,132 @@
+package cortextest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedCustomData stores custom data on an entity as if it had been set through POST /api/v1/catalog/:tag/custom-data.
+// The entity must already exist.
+func (s *Server) SeedCustomData(entityTag string, data cortex.CatalogEntityCustomData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entities[entityTag]; !ok {
+		return fmt.Errorf("catalog entity %s does not exist", entityTag)
+	}
+	s.storeCustomData(entityTag, data.ToUpsertRequest())
+	return nil
+}
+
+func (s *Server) storeCustomData(entityTag string, req cortex.UpsertCatalogEntityCustomDataRequest) cortex.CatalogEntityCustomData {
+	if s.customData[entityTag] == nil {
+		s.customData[entityTag] = map[string]cortex.CatalogEntityCustomData{}
+	}
+	data := cortex.CatalogEntityCustomData{
+		Tag:         entityTag,
+		Key:         req.Key,
+		Description: req.Description,
+		Source:      cortex.CatalogEntityCustomDataSourceApi,
+		Value:       req.Value,
+		DateUpdated: time.Now().UTC().Format(time.RFC3339),
+	}
+	s.customData[entityTag][req.Key] = data
+	return data
+}
+
+// entityCustomData returns all custom data on an entity: keys from x-cortex-custom-metadata in its descriptor, and
+// keys set through the API, which take precedence.
+func (s *Server) entityCustomData(entityTag string) map[string]cortex.CatalogEntityCustomData {
+	all := map[string]cortex.CatalogEntityCustomData{}
+	if info, ok := s.entities[entityTag]["info"].(map[string]interface{}); ok {
+		if metadata, ok := info["x-cortex-custom-metadata"].(map[string]interface{}); ok {
+			for key, value := range metadata {
+				all[key] = cortex.CatalogEntityCustomData{
+					Tag:    entityTag,
+					Key:    key,
+					Source: cortex.CatalogEntityCustomDataSourceYaml,
+					Value:  value,
+				}
+			}
+		}
+	}
+	for key, data := range s.customData[entityTag] {
+		all[key] = data
+	}
+	return all
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/custom-data
+ **********************************************************************************************************************/
+
+func (s *Server) listCustomData(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	all := s.entityCustomData(params[0])
+	resp := []cortex.CatalogEntityCustomData{}
+	for _, key := range sortedKeys(all) {
+		resp = append(resp, all[key])
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/custom-data/:key
+ **********************************************************************************************************************/
+
+func (s *Server) getCustomData(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	data, ok := s.entityCustomData(params[0])[params[1]]
+	if !ok {
+		s.writeNotFound(w, "custom data key", params[1])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, data)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/custom-data
+ **********************************************************************************************************************/
+
+func (s *Server) upsertCustomData(w http.ResponseWriter, req *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	body := cortex.UpsertCatalogEntityCustomDataRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Key == "" {
+		s.writeBadRequest(w, "key is required")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, s.storeCustomData(params[0], body))
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/custom-data?key=
+ **********************************************************************************************************************/
+
+func (s *Server) deleteCustomData(w http.ResponseWriter, req *http.Request, params []string) {
+	key := req.URL.Query().Get("key")
+	if _, ok := s.customData[params[0]][key]; !ok {
+		s.writeNotFound(w, "custom data key", key)
+		return
+	}
+	delete(s.customData[params[0]], key)
+	w.WriteHeader(http.StatusOK)
+}
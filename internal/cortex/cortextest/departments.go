@@ -0,0 +1,96 @@
+package cortextest
+
+import (
+	"net/http"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedDepartment stores a department as if it had been created through POST /api/v1/teams/departments.
+func (s *Server) SeedDepartment(department cortex.Department) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.departments[department.Tag] = department
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/teams/departments?departmentTag=
+ **********************************************************************************************************************/
+
+func (s *Server) getDepartment(w http.ResponseWriter, req *http.Request, _ []string) {
+	tag := req.URL.Query().Get("departmentTag")
+	department, ok := s.departments[tag]
+	if !ok {
+		s.writeNotFound(w, "department", tag)
+		return
+	}
+	s.writeJSON(w, http.StatusOK, department)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/teams/departments
+ **********************************************************************************************************************/
+
+func (s *Server) createDepartment(w http.ResponseWriter, req *http.Request, _ []string) {
+	body := cortex.CreateDepartmentRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Tag == "" {
+		s.writeBadRequest(w, "departmentTag is required")
+		return
+	}
+	if _, ok := s.departments[body.Tag]; ok {
+		s.writeConflict(w, "department", body.Tag)
+		return
+	}
+	department := cortex.Department{
+		Tag:         body.Tag,
+		Name:        body.Name,
+		Description: body.Description,
+		Members:     body.Members,
+	}
+	s.departments[department.Tag] = department
+	s.writeJSON(w, http.StatusOK, department)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/teams/departments/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) updateDepartment(w http.ResponseWriter, req *http.Request, params []string) {
+	department, ok := s.departments[params[0]]
+	if !ok {
+		s.writeNotFound(w, "department", params[0])
+		return
+	}
+	body := cortex.UpdateDepartmentRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	department.Name = body.Name
+	department.Description = body.Description
+	department.Members = body.Members
+	s.departments[department.Tag] = department
+	s.writeJSON(w, http.StatusOK, department)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/teams/departments?departmentTag=
+ **********************************************************************************************************************/
+
+func (s *Server) deleteDepartment(w http.ResponseWriter, req *http.Request, _ []string) {
+	tag := req.URL.Query().Get("departmentTag")
+	if _, ok := s.departments[tag]; !ok {
+		s.writeNotFound(w, "department", tag)
+		return
+	}
+	delete(s.departments, tag)
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,106 @@
+package cortextest
+
+import (
+	"net/http"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+// requestDependency returns the dependency a request to the dependency endpoints is for, from its path and query.
+func requestDependency(req *http.Request, params []string) cortex.Dependency {
+	return cortex.Dependency{
+		CallerTag: params[0],
+		CalleeTag: params[1],
+		Method:    req.URL.Query().Get("method"),
+		Path:      req.URL.Query().Get("path"),
+	}
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (s *Server) getDependency(w http.ResponseWriter, req *http.Request, params []string) {
+	dependency := requestDependency(req, params)
+	existing, ok := s.dependencies[dependency.ID()]
+	if !ok {
+		s.writeNotFound(w, "dependency", dependency.ID())
+		return
+	}
+	s.writeJSON(w, http.StatusOK, existing)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (s *Server) createDependency(w http.ResponseWriter, req *http.Request, params []string) {
+	for _, tag := range params {
+		if _, ok := s.entities[tag]; !ok {
+			s.writeNotFound(w, "catalog entity", tag)
+			return
+		}
+	}
+	dependency := requestDependency(req, params)
+	if (dependency.Method == "") != (dependency.Path == "") {
+		s.writeBadRequest(w, "method and path must be set together")
+		return
+	}
+	if _, ok := s.dependencies[dependency.ID()]; ok {
+		s.writeConflict(w, "dependency", dependency.ID())
+		return
+	}
+	body := cortex.UpsertDependencyRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	dependency.Description = body.Description
+	dependency.Metadata = body.Metadata
+	s.dependencies[dependency.ID()] = dependency
+	s.writeJSON(w, http.StatusOK, dependency)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (s *Server) updateDependency(w http.ResponseWriter, req *http.Request, params []string) {
+	dependency := requestDependency(req, params)
+	if _, ok := s.dependencies[dependency.ID()]; !ok {
+		s.writeNotFound(w, "dependency", dependency.ID())
+		return
+	}
+	body := cortex.UpsertDependencyRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	dependency.Description = body.Description
+	dependency.Metadata = body.Metadata
+	s.dependencies[dependency.ID()] = dependency
+	s.writeJSON(w, http.StatusOK, dependency)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (s *Server) deleteDependency(w http.ResponseWriter, req *http.Request, params []string) {
+	dependency := requestDependency(req, params)
+	if _, ok := s.dependencies[dependency.ID()]; !ok {
+		s.writeNotFound(w, "dependency", dependency.ID())
+		return
+	}
+	delete(s.dependencies, dependency.ID())
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteEntityDependencies removes the dependencies from and to an entity.
+func (s *Server) deleteEntityDependencies(tag string) {
+	for id, dependency := range s.dependencies {
+		if dependency.CallerTag == tag || dependency.CalleeTag == tag {
+			delete(s.dependencies, id)
+		}
+	}
+}
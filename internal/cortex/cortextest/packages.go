@@ -0,0 +1,119 @@
+package cortextest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+// packageTypes maps the paths of the package endpoints to the types of package they manage.
+var packageTypes = map[string]string{
+	"go":           cortex.PackageTypeGo,
+	"java":         cortex.PackageTypeJava,
+	"node":         cortex.PackageTypeNode,
+	"dotnet/nuget": cortex.PackageTypeNuGet,
+	"python":       cortex.PackageTypePython,
+}
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedPackage stores a package on an entity, as if Cortex had found it in the entity's repository. The entity must
+// already exist.
+func (s *Server) SeedPackage(entityTag string, pkg cortex.Package) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entities[entityTag]; !ok {
+		return fmt.Errorf("catalog entity %s does not exist", entityTag)
+	}
+	s.storePackage(entityTag, pkg.PackageType, pkg.Name, pkg.Version)
+	return nil
+}
+
+// storePackage creates a package, or sets the version of the package with the same type and name.
+func (s *Server) storePackage(entityTag string, packageType string, name string, version string) cortex.Package {
+	for i, pkg := range s.packages[entityTag] {
+		if pkg.PackageType == packageType && pkg.Name == name {
+			s.packages[entityTag][i].Version = version
+			return s.packages[entityTag][i]
+		}
+	}
+	pkg := cortex.Package{
+		ID:          s.packageId.Add(1),
+		Name:        name,
+		Version:     version,
+		PackageType: packageType,
+		DateCreated: time.Now().UTC().Format(time.RFC3339),
+	}
+	s.packages[entityTag] = append(s.packages[entityTag], pkg)
+	return pkg
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/packages
+ **********************************************************************************************************************/
+
+func (s *Server) listPackages(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	resp := append([]cortex.Package{}, s.packages[params[0]]...)
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/packages/java/single
+ **********************************************************************************************************************/
+
+// upsertPackage only serves Java packages, which are the only ones Cortex can upsert one at a time.
+func (s *Server) upsertPackage(w http.ResponseWriter, req *http.Request, params []string) {
+	if _, ok := s.entities[params[0]]; !ok {
+		s.writeNotFound(w, "catalog entity", params[0])
+		return
+	}
+	body := cortex.UpsertPackageRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Name == "" || body.Version == "" {
+		s.writeBadRequest(w, "name and version are required")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, s.storePackage(params[0], cortex.PackageTypeJava, body.Name, body.Version))
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/packages/:type?name=
+ **********************************************************************************************************************/
+
+func (s *Server) deletePackage(w http.ResponseWriter, req *http.Request, params []string) {
+	packageType, ok := packageTypes[packageTypePath(params)]
+	if !ok {
+		s.writeNotFound(w, "package type", packageTypePath(params))
+		return
+	}
+	name := req.URL.Query().Get("name")
+	for i, pkg := range s.packages[params[0]] {
+		if pkg.PackageType == packageType && pkg.Name == name {
+			s.packages[params[0]] = append(s.packages[params[0]][:i], s.packages[params[0]][i+1:]...)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	s.writeNotFound(w, "package", name)
+}
+
+// packageTypePath returns the path of the package endpoint a request was routed to, which is the only parameter after
+// the entity tag, or dotnet/nuget for the routes that have no parameter for it.
+func packageTypePath(params []string) string {
+	if len(params) > 1 {
+		return strings.ToLower(params[1])
+	}
+	return "dotnet/nuget"
+}
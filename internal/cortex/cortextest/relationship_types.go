@@ -0,0 +1,98 @@
+package cortextest
+
+import (
+	"net/http"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+// setRelationshipType sets the attributes of a relationship type from a request, with the defaults of the API for the
+// ones the request leaves empty.
+func setRelationshipType(relationshipType *cortex.RelationshipType, body cortex.UpdateRelationshipTypeRequest) {
+	relationshipType.Name = body.Name
+	relationshipType.Description = body.Description
+	relationshipType.DefinitionLocation = body.DefinitionLocation
+	if relationshipType.DefinitionLocation == "" {
+		relationshipType.DefinitionLocation = cortex.RelationshipTypeDefinitionLocationSource
+	}
+	relationshipType.AllowCycles = body.AllowCycles
+	relationshipType.CreateCatalog = body.CreateCatalog
+	relationshipType.IsSingleSource = body.IsSingleSource
+	relationshipType.IsSingleDestination = body.IsSingleDestination
+	relationshipType.SourcesFilter = cortex.NewRelationshipTypeFilter(body.SourcesFilter.EntityTypes())
+	relationshipType.DestinationsFilter = cortex.NewRelationshipTypeFilter(body.DestinationsFilter.EntityTypes())
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) getRelationshipType(w http.ResponseWriter, _ *http.Request, params []string) {
+	relationshipType, ok := s.relationshipTypes[params[0]]
+	if !ok {
+		s.writeNotFound(w, "relationship type", params[0])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, relationshipType)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/relationship-types
+ **********************************************************************************************************************/
+
+func (s *Server) createRelationshipType(w http.ResponseWriter, req *http.Request, _ []string) {
+	body := cortex.CreateRelationshipTypeRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Tag == "" || body.Name == "" {
+		s.writeBadRequest(w, "tag and name are required")
+		return
+	}
+	if _, ok := s.relationshipTypes[body.Tag]; ok {
+		s.writeConflict(w, "relationship type", body.Tag)
+		return
+	}
+	relationshipType := cortex.RelationshipType{Tag: body.Tag}
+	setRelationshipType(&relationshipType, body.UpdateRelationshipTypeRequest)
+	s.relationshipTypes[relationshipType.Tag] = relationshipType
+	s.writeJSON(w, http.StatusOK, relationshipType)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) updateRelationshipType(w http.ResponseWriter, req *http.Request, params []string) {
+	relationshipType, ok := s.relationshipTypes[params[0]]
+	if !ok {
+		s.writeNotFound(w, "relationship type", params[0])
+		return
+	}
+	body := cortex.UpdateRelationshipTypeRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Name == "" {
+		s.writeBadRequest(w, "name is required")
+		return
+	}
+	setRelationshipType(&relationshipType, body)
+	s.relationshipTypes[relationshipType.Tag] = relationshipType
+	s.writeJSON(w, http.StatusOK, relationshipType)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) deleteRelationshipType(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.relationshipTypes[params[0]]; !ok {
+		s.writeNotFound(w, "relationship type", params[0])
+		return
+	}
+	delete(s.relationshipTypes, params[0])
+	w.WriteHeader(http.StatusOK)
+}
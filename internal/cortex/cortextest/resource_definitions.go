@@ -0,0 +1,120 @@
+package cortextest
+
+import (
+	"net/http"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedResourceDefinition stores a resource definition as if it had been created through
+// POST /api/v1/catalog/definitions.
+func (s *Server) SeedResourceDefinition(definition cortex.ResourceDefinition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.definitions[definition.Type] = definition
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/definitions
+ **********************************************************************************************************************/
+
+func (s *Server) listResourceDefinitions(w http.ResponseWriter, req *http.Request, _ []string) {
+	definitions := []cortex.ResourceDefinition{}
+	for _, typeName := range sortedKeys(s.definitions) {
+		definitions = append(definitions, s.definitions[typeName])
+	}
+
+	page, pageSize, err := pageParams(req)
+	if err != nil {
+		s.writeBadRequest(w, err.Error())
+		return
+	}
+	items, totalPages := paginate(definitions, page, pageSize)
+	s.writeJSON(w, http.StatusOK, cortex.ResourceDefinitionsResponse{
+		ResourceDefinitions: items,
+		Page:                page,
+		Total:               len(definitions),
+		TotalPages:          totalPages,
+	})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/definitions/:typeName
+ **********************************************************************************************************************/
+
+func (s *Server) getResourceDefinition(w http.ResponseWriter, _ *http.Request, params []string) {
+	definition, ok := s.definitions[params[0]]
+	if !ok {
+		s.writeNotFound(w, "resource definition", params[0])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, definition)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/definitions
+ **********************************************************************************************************************/
+
+func (s *Server) createResourceDefinition(w http.ResponseWriter, req *http.Request, _ []string) {
+	body := cortex.CreateResourceDefinitionRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.Type == "" {
+		s.writeBadRequest(w, "type is required")
+		return
+	}
+	if _, ok := s.definitions[body.Type]; ok {
+		s.writeConflict(w, "resource definition", body.Type)
+		return
+	}
+	definition := cortex.ResourceDefinition{
+		Type:        body.Type,
+		Name:        body.Name,
+		Description: body.Description,
+		Schema:      body.Schema,
+		Source:      body.Source,
+	}
+	s.definitions[definition.Type] = definition
+	s.writeJSON(w, http.StatusOK, definition)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/definitions/:typeName
+ **********************************************************************************************************************/
+
+func (s *Server) updateResourceDefinition(w http.ResponseWriter, req *http.Request, params []string) {
+	definition, ok := s.definitions[params[0]]
+	if !ok {
+		s.writeNotFound(w, "resource definition", params[0])
+		return
+	}
+	body := cortex.UpdateResourceDefinitionRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	definition.Name = body.Name
+	definition.Description = body.Description
+	definition.Schema = body.Schema
+	s.definitions[definition.Type] = definition
+	s.writeJSON(w, http.StatusOK, definition)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/definitions/:typeName
+ **********************************************************************************************************************/
+
+func (s *Server) deleteResourceDefinition(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.definitions[params[0]]; !ok {
+		s.writeNotFound(w, "resource definition", params[0])
+		return
+	}
+	delete(s.definitions, params[0])
+	w.WriteHeader(http.StatusOK)
+}
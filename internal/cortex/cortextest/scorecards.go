@@ -0,0 +1,89 @@
+package cortextest
+
+import (
+	"errors"
+	"net/http"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedScorecard stores a scorecard descriptor as if it had been upserted through POST /api/v1/scorecards/descriptor.
+func (s *Server) SeedScorecard(descriptor string) error {
+	doc, err := unmarshalYAML(descriptor)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.storeScorecard(doc)
+}
+
+func (s *Server) storeScorecard(doc map[string]interface{}) error {
+	tag, _ := doc["tag"].(string)
+	if tag == "" {
+		return errors.New("tag is required")
+	}
+	if name, _ := doc["name"].(string); name == "" {
+		return errors.New("name is required")
+	}
+	s.scorecards[tag] = doc
+	return nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/scorecards/descriptor
+ **********************************************************************************************************************/
+
+func (s *Server) upsertScorecard(w http.ResponseWriter, req *http.Request, _ []string) {
+	doc, err := decodeYAML(req)
+	if err != nil {
+		s.writeBadRequest(w, "could not parse descriptor: "+err.Error())
+		return
+	}
+	if err := s.storeScorecard(doc); err != nil {
+		s.writeBadRequest(w, err.Error())
+		return
+	}
+	s.writeJSON(w, http.StatusOK, map[string]interface{}{"scorecard": doc})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/scorecards/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) getScorecard(w http.ResponseWriter, _ *http.Request, params []string) {
+	doc, ok := s.scorecards[params[0]]
+	if !ok {
+		s.writeNotFound(w, "scorecard", params[0])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, map[string]interface{}{"scorecard": doc})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/scorecards/:tag/descriptor
+ **********************************************************************************************************************/
+
+func (s *Server) getScorecardDescriptor(w http.ResponseWriter, _ *http.Request, params []string) {
+	doc, ok := s.scorecards[params[0]]
+	if !ok {
+		s.writeNotFound(w, "scorecard", params[0])
+		return
+	}
+	s.writeYAML(w, http.StatusOK, doc)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/scorecards/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) deleteScorecard(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := s.scorecards[params[0]]; !ok {
+		s.writeNotFound(w, "scorecard", params[0])
+		return
+	}
+	delete(s.scorecards, params[0])
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,293 @@
+// Package cortextest provides a stateful, in-memory fake of the Cortex API for tests that cannot reach a real Cortex
+// tenant. It implements the endpoints used by the cortex package, with the same JSON and YAML shapes.
+package cortextest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"gopkg.in/yaml.v3"
+)
+
+// Token is the API token the fake server accepts. Requests with any other bearer token are rejected with a 401.
+const Token = "cortextest-token"
+
+// Server is an in-memory fake of the Cortex API, listening on a local httptest server.
+type Server struct {
+	*httptest.Server
+
+	mu                sync.Mutex
+	requestId         atomic.Int64
+	entities          map[string]map[string]interface{}                    // entity tag -> OpenAPI descriptor
+	customData        map[string]map[string]cortex.CatalogEntityCustomData // entity tag -> key -> custom data set via the API
+	packages          map[string][]cortex.Package                          // entity tag -> packages
+	packageId         atomic.Int64
+	dependencies      map[string]cortex.Dependency      // dependency ID -> dependency set via the API
+	scorecards        map[string]map[string]interface{} // scorecard tag -> descriptor
+	teams             map[string]cortex.Team
+	departments       map[string]cortex.Department
+	definitions       map[string]cortex.ResourceDefinition
+	relationshipTypes map[string]cortex.RelationshipType
+}
+
+// NewServer starts a fake Cortex API server with no data. The caller must call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		entities:          map[string]map[string]interface{}{},
+		customData:        map[string]map[string]cortex.CatalogEntityCustomData{},
+		packages:          map[string][]cortex.Package{},
+		dependencies:      map[string]cortex.Dependency{},
+		scorecards:        map[string]map[string]interface{}{},
+		teams:             map[string]cortex.Team{},
+		departments:       map[string]cortex.Department{},
+		definitions:       map[string]cortex.ResourceDefinition{},
+		relationshipTypes: map[string]cortex.RelationshipType{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+/***********************************************************************************************************************
+ * Routing
+ **********************************************************************************************************************/
+
+type handlerFunc func(w http.ResponseWriter, req *http.Request, params []string)
+
+type route struct {
+	method  string
+	pattern []string // path segments after /api/v1; "*" matches any single segment
+	handler handlerFunc
+}
+
+// routes are matched in order, so literal segments must come before wildcards that would also match them.
+func (s *Server) routes() []route {
+	return []route{
+		{http.MethodPost, []string{"open-api"}, s.upsertCatalogEntity},
+
+		{http.MethodGet, []string{"catalog", "definitions"}, s.listResourceDefinitions},
+		{http.MethodPost, []string{"catalog", "definitions"}, s.createResourceDefinition},
+		{http.MethodGet, []string{"catalog", "definitions", "*"}, s.getResourceDefinition},
+		{http.MethodPut, []string{"catalog", "definitions", "*"}, s.updateResourceDefinition},
+		{http.MethodDelete, []string{"catalog", "definitions", "*"}, s.deleteResourceDefinition},
+
+		{http.MethodGet, []string{"catalog"}, s.listCatalogEntities},
+		{http.MethodGet, []string{"catalog", "*"}, s.getCatalogEntity},
+		{http.MethodDelete, []string{"catalog", "*"}, s.deleteCatalogEntity},
+		{http.MethodGet, []string{"catalog", "*", "openapi"}, s.getCatalogEntityDescriptor},
+		{http.MethodGet, []string{"catalog", "*", "custom-data"}, s.listCustomData},
+		{http.MethodPost, []string{"catalog", "*", "custom-data"}, s.upsertCustomData},
+		{http.MethodDelete, []string{"catalog", "*", "custom-data"}, s.deleteCustomData},
+		{http.MethodGet, []string{"catalog", "*", "custom-data", "*"}, s.getCustomData},
+		{http.MethodGet, []string{"catalog", "*", "packages"}, s.listPackages},
+		{http.MethodDelete, []string{"catalog", "*", "packages", "dotnet", "nuget"}, s.deletePackage},
+		{http.MethodPost, []string{"catalog", "*", "packages", "java", "single"}, s.upsertPackage},
+		{http.MethodDelete, []string{"catalog", "*", "packages", "*"}, s.deletePackage},
+		{http.MethodGet, []string{"catalog", "*", "dependencies", "*"}, s.getDependency},
+		{http.MethodPost, []string{"catalog", "*", "dependencies", "*"}, s.createDependency},
+		{http.MethodPut, []string{"catalog", "*", "dependencies", "*"}, s.updateDependency},
+		{http.MethodDelete, []string{"catalog", "*", "dependencies", "*"}, s.deleteDependency},
+
+		{http.MethodPost, []string{"relationship-types"}, s.createRelationshipType},
+		{http.MethodGet, []string{"relationship-types", "*"}, s.getRelationshipType},
+		{http.MethodPut, []string{"relationship-types", "*"}, s.updateRelationshipType},
+		{http.MethodDelete, []string{"relationship-types", "*"}, s.deleteRelationshipType},
+
+		{http.MethodPost, []string{"scorecards", "descriptor"}, s.upsertScorecard},
+		{http.MethodGet, []string{"scorecards", "*"}, s.getScorecard},
+		{http.MethodDelete, []string{"scorecards", "*"}, s.deleteScorecard},
+		{http.MethodGet, []string{"scorecards", "*", "descriptor"}, s.getScorecardDescriptor},
+
+		{http.MethodGet, []string{"teams", "departments"}, s.getDepartment},
+		{http.MethodPost, []string{"teams", "departments"}, s.createDepartment},
+		{http.MethodDelete, []string{"teams", "departments"}, s.deleteDepartment},
+		{http.MethodPut, []string{"teams", "departments", "*"}, s.updateDepartment},
+
+		{http.MethodGet, []string{"teams"}, s.listTeams},
+		{http.MethodPost, []string{"teams"}, s.createTeam},
+		{http.MethodDelete, []string{"teams"}, s.deleteTeam},
+		{http.MethodGet, []string{"teams", "*"}, s.getTeam},
+		{http.MethodPut, []string{"teams", "*"}, s.updateTeam},
+		{http.MethodPut, []string{"teams", "*", "archive"}, s.archiveTeam},
+		{http.MethodPut, []string{"teams", "*", "unarchive"}, s.unarchiveTeam},
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("X-Request-Id", fmt.Sprintf("cortextest-%d", s.requestId.Add(1)))
+
+	if req.Header.Get("Authorization") != "Bearer "+Token {
+		s.writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "invalid or missing API token")
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(req.URL.Path, "/api/v1"), "/")
+	segments := strings.Split(path, "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	methodAllowed := false
+	for _, r := range s.routes() {
+		params, ok := matchRoute(r.pattern, segments)
+		if !ok {
+			continue
+		}
+		if r.method != req.Method {
+			methodAllowed = true
+			continue
+		}
+		r.handler(w, req, params)
+		return
+	}
+	if methodAllowed {
+		s.writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", req.Method+" is not supported on "+req.URL.Path)
+		return
+	}
+	s.writeError(w, http.StatusNotFound, "NOT_FOUND", "no such endpoint: "+req.URL.Path)
+}
+
+// matchRoute returns the segments matched by the wildcards of pattern.
+func matchRoute(pattern []string, segments []string) ([]string, bool) {
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	var params []string
+	for i, p := range pattern {
+		if p == "*" {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params = append(params, segments[i])
+			continue
+		}
+		if p != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+/***********************************************************************************************************************
+ * Responses
+ **********************************************************************************************************************/
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func (s *Server) writeYAML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(status)
+	_ = yaml.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, errorType string, message string) {
+	s.writeJSON(w, status, cortex.ApiError{
+		HttpStatus: status,
+		Message:    message,
+		RequestId:  w.Header().Get("X-Request-Id"),
+		Type:       errorType,
+	})
+}
+
+func (s *Server) writeNotFound(w http.ResponseWriter, kind string, id string) {
+	s.writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("%s %s not found", kind, id))
+}
+
+func (s *Server) writeBadRequest(w http.ResponseWriter, message string) {
+	s.writeError(w, http.StatusBadRequest, "BAD_REQUEST", message)
+}
+
+func (s *Server) writeConflict(w http.ResponseWriter, kind string, id string) {
+	s.writeError(w, http.StatusConflict, "CONFLICT", fmt.Sprintf("%s %s already exists", kind, id))
+}
+
+/***********************************************************************************************************************
+ * Requests
+ **********************************************************************************************************************/
+
+func decodeJSON(req *http.Request, v interface{}) error {
+	return json.NewDecoder(req.Body).Decode(v)
+}
+
+func decodeYAML(req *http.Request) (map[string]interface{}, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalYAML(string(body))
+}
+
+func unmarshalYAML(s string) (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// queryValues returns every value of a query parameter, accepting both repeated and comma-separated forms.
+func queryValues(req *http.Request, key string) []string {
+	var values []string
+	for _, v := range req.URL.Query()[key] {
+		for _, part := range strings.Split(v, ",") {
+			if part != "" {
+				values = append(values, part)
+			}
+		}
+	}
+	return values
+}
+
+// defaultPageSize is the page size used by list endpoints when the request does not set pageSize.
+const defaultPageSize = 250
+
+// pageParams reads the zero-based page and pageSize query parameters of a list request.
+func pageParams(req *http.Request) (int, int, error) {
+	page, pageSize := 0, defaultPageSize
+	if v := req.URL.Query().Get("page"); v != "" {
+		p, err := strconv.Atoi(v)
+		if err != nil || p < 0 {
+			return 0, 0, fmt.Errorf("invalid page %q", v)
+		}
+		page = p
+	}
+	if v := req.URL.Query().Get("pageSize"); v != "" {
+		p, err := strconv.Atoi(v)
+		if err != nil || p < 1 {
+			return 0, 0, fmt.Errorf("invalid pageSize %q", v)
+		}
+		pageSize = p
+	}
+	return page, pageSize, nil
+}
+
+// paginate returns the items on the given page, which is empty past the last page, and the total number of pages.
+func paginate[T any](items []T, page int, pageSize int) ([]T, int) {
+	totalPages := (len(items) + pageSize - 1) / pageSize
+	start := min(page*pageSize, len(items))
+	end := min(start+pageSize, len(items))
+	return items[start:end], totalPages
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
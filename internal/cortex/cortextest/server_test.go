@@ -0,0 +1,330 @@
+package cortextest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex/cortextest"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildClient(t *testing.T, token string) (*cortex.HttpClient, *cortextest.Server) {
+	server := cortextest.NewServer()
+	t.Cleanup(server.Close)
+
+	c, err := cortex.NewClient(
+		cortex.WithURL(server.URL),
+		cortex.WithToken(token),
+		cortex.WithVersion("test"),
+		cortex.WithMaxRetries(0),
+	)
+	assert.Nil(t, err, "could not build client")
+	return c, server
+}
+
+func TestServerRejectsInvalidToken(t *testing.T) {
+	c, _ := buildClient(t, "not-the-token")
+
+	_, err := c.Teams().Get(context.Background(), "test-team")
+	assert.ErrorIs(t, err, cortex.ApiErrorUnauthorized)
+}
+
+func TestServerCatalogEntityLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+
+	_, err := c.CatalogEntities().GetFromDescriptor(ctx, "test-entity")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	entity, err := c.CatalogEntities().Upsert(ctx, cortex.UpsertCatalogEntityRequest{
+		Info: cortex.CatalogEntityData{
+			Tag:    "test-entity",
+			Title:  "Test Entity",
+			Type:   "service",
+			Groups: []string{"test-group"},
+			Owners: []cortex.CatalogEntityOwner{
+				{Type: "EMAIL", Email: "owner@cortex.io"},
+			},
+			Metadata: map[string]interface{}{"from-yaml": "yes"},
+		},
+	})
+	assert.Nil(t, err, "could not upsert catalog entity")
+	assert.Equal(t, "test-entity", entity.Tag)
+	assert.Equal(t, "Test Entity", entity.Title)
+	assert.Equal(t, []string{"test-group"}, entity.Groups)
+
+	got, err := c.CatalogEntities().Get(ctx, "test-entity")
+	assert.Nil(t, err, "could not get catalog entity")
+	assert.Equal(t, "service", got.Type)
+	assert.Equal(t, "owner@cortex.io", got.Ownership.Emails[0].Email)
+
+	list, err := c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{Types: []string{"domain"}})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Empty(t, list.Entities)
+	list, err = c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{Groups: []string{"test-group"}})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Len(t, list.Entities, 1)
+
+	data, err := c.CatalogEntityCustomData().Upsert(ctx, "test-entity", cortex.UpsertCatalogEntityCustomDataRequest{
+		Key:   "from-api",
+		Value: map[string]interface{}{"nested": true},
+	})
+	assert.Nil(t, err, "could not upsert custom data")
+	assert.Equal(t, "API", data.Source)
+
+	data, err = c.CatalogEntityCustomData().Get(ctx, "test-entity", "from-yaml")
+	assert.Nil(t, err, "could not get custom data from the descriptor")
+	assert.Equal(t, "YAML", data.Source)
+	assert.Equal(t, "yes", data.Value)
+
+	err = c.CatalogEntityCustomData().Delete(ctx, "test-entity", "from-api")
+	assert.Nil(t, err, "could not delete custom data")
+	_, err = c.CatalogEntityCustomData().Get(ctx, "test-entity", "from-api")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	err = c.CatalogEntities().Delete(ctx, "test-entity")
+	assert.Nil(t, err, "could not delete catalog entity")
+	_, err = c.CatalogEntities().Get(ctx, "test-entity")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
+
+func TestServerListCatalogEntities(t *testing.T) {
+	ctx := context.Background()
+	c, server := buildClient(t, cortextest.Token)
+
+	for _, tag := range []string{"alpha", "bravo", "charlie"} {
+		err := server.SeedCatalogEntity(`
+openapi: 3.0.1
+info:
+  title: Service ` + tag + `
+  x-cortex-tag: ` + tag + `
+  x-cortex-type: service
+  x-cortex-owners:
+    - type: group
+      name: team-` + tag + `
+`)
+		assert.Nil(t, err, "could not seed catalog entity")
+	}
+
+	list, err := c.CatalogEntities().ListPage(ctx, &cortex.CatalogEntityListParams{Page: 1, PageSize: 2})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Equal(t, 3, list.Total)
+	assert.Equal(t, 2, list.TotalPages)
+	assert.Len(t, list.Entities, 1)
+	assert.Equal(t, "charlie", list.Entities[0].Tag)
+
+	list, err = c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{PageSize: 2})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Len(t, list.Entities, 3, "expected every page to be listed")
+
+	list, err = c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{Owners: []string{"team-bravo"}})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Len(t, list.Entities, 1)
+	assert.Equal(t, "bravo", list.Entities[0].Tag)
+
+	list, err = c.CatalogEntities().List(ctx, &cortex.CatalogEntityListParams{Query: "SERVICE ALPHA"})
+	assert.Nil(t, err, "could not list catalog entities")
+	assert.Len(t, list.Entities, 1)
+	assert.Equal(t, "alpha", list.Entities[0].Tag)
+}
+
+func TestServerScorecardLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+
+	scorecard, err := c.Scorecards().Upsert(ctx, cortex.Scorecard{
+		Tag:  "test-scorecard",
+		Name: "Test Scorecard",
+		Rules: []cortex.ScorecardRule{
+			{Title: "Has an owner", Expression: "ownership != null", Weight: 1},
+		},
+	})
+	assert.Nil(t, err, "could not upsert scorecard")
+	assert.Equal(t, "Test Scorecard", scorecard.Name)
+	assert.Len(t, scorecard.Rules, 1)
+
+	err = c.Scorecards().Delete(ctx, "test-scorecard")
+	assert.Nil(t, err, "could not delete scorecard")
+	_, err = c.Scorecards().Get(ctx, "test-scorecard")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
+
+func TestServerTeamLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+
+	req := cortex.CreateTeamRequest{TeamTag: "test-team", Metadata: cortex.TeamMetadata{Name: "Test Team"}}
+	_, err := c.Teams().Create(ctx, req)
+	assert.Nil(t, err, "could not create team")
+	_, err = c.Teams().Create(ctx, req)
+	assert.ErrorIs(t, err, cortex.ApiErrorConflict)
+
+	_, err = c.Teams().Update(ctx, "test-team", cortex.UpdateTeamRequest{Metadata: cortex.TeamMetadata{Name: "Renamed"}})
+	assert.Nil(t, err, "could not update team")
+	assert.Nil(t, c.Teams().Archive(ctx, "test-team"), "could not archive team")
+
+	team, err := c.Teams().Get(ctx, "test-team")
+	assert.Nil(t, err, "could not get team")
+	assert.Equal(t, "Renamed", team.Metadata.Name)
+	assert.True(t, team.IsArchived)
+	_, err = c.Teams().Update(ctx, "test-team", cortex.UpdateTeamRequest{Metadata: cortex.TeamMetadata{Name: "Archived"}})
+	assert.ErrorIs(t, err, cortex.ApiErrorValidation)
+
+	assert.Nil(t, c.Teams().Delete(ctx, "test-team"), "could not delete team")
+	teams, err := c.Teams().List(ctx, nil)
+	assert.Nil(t, err, "could not list teams")
+	assert.Empty(t, teams.Teams)
+}
+
+func TestServerDepartmentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, server := buildClient(t, cortextest.Token)
+	server.SeedDepartment(cortex.Department{Tag: "seeded-department", Name: "Seeded"})
+
+	department, err := c.Departments().Get(ctx, "seeded-department")
+	assert.Nil(t, err, "could not get seeded department")
+	assert.Equal(t, "Seeded", department.Name)
+
+	_, err = c.Departments().Create(ctx, cortex.CreateDepartmentRequest{Tag: "test-department", Name: "Test"})
+	assert.Nil(t, err, "could not create department")
+	department, err = c.Departments().Update(ctx, "test-department", cortex.UpdateDepartmentRequest{Name: "Renamed"})
+	assert.Nil(t, err, "could not update department")
+	assert.Equal(t, "Renamed", department.Name)
+
+	assert.Nil(t, c.Departments().Delete(ctx, "test-department"), "could not delete department")
+	_, err = c.Departments().Get(ctx, "test-department")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
+
+func TestServerResourceDefinitionLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+
+	_, err := c.ResourceDefinitions().Create(ctx, cortex.CreateResourceDefinitionRequest{
+		Type:   "test-definition",
+		Name:   "Test Definition",
+		Schema: map[string]interface{}{"type": "object"},
+	})
+	assert.Nil(t, err, "could not create resource definition")
+
+	definitions, err := c.ResourceDefinitions().List(ctx, nil)
+	assert.Nil(t, err, "could not list resource definitions")
+	assert.Len(t, definitions.ResourceDefinitions, 1)
+
+	definition, err := c.ResourceDefinitions().Get(ctx, "test-definition")
+	assert.Nil(t, err, "could not get resource definition")
+	assert.Equal(t, "object", definition.Schema["type"])
+
+	assert.Nil(t, c.ResourceDefinitions().Delete(ctx, "test-definition"), "could not delete resource definition")
+	_, err = c.ResourceDefinitions().Get(ctx, "test-definition")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
+
+func TestServerPackageLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, server := buildClient(t, cortextest.Token)
+
+	_, err := c.Packages().List(ctx, "test-entity")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	_, err = c.CatalogEntities().Upsert(ctx, cortex.UpsertCatalogEntityRequest{
+		Info: cortex.CatalogEntityData{Tag: "test-entity", Title: "Test Entity", Type: "service"},
+	})
+	assert.Nil(t, err, "could not upsert catalog entity")
+	assert.Nil(t, server.SeedPackage("test-entity", cortex.Package{PackageType: cortex.PackageTypeGo, Name: "github.com/dghubble/sling", Version: "1.4.1"}))
+
+	pkg, err := c.Packages().Upsert(ctx, "test-entity", cortex.UpsertPackageRequest{PackageType: cortex.PackageTypeJava, Name: "com.example:billing-client", Version: "1.8.3"})
+	assert.Nil(t, err, "could not upsert package")
+	assert.Equal(t, cortex.PackageTypeJava, pkg.PackageType)
+	assert.NotZero(t, pkg.ID)
+
+	updated, err := c.Packages().Upsert(ctx, "test-entity", cortex.UpsertPackageRequest{PackageType: cortex.PackageTypeJava, Name: "com.example:billing-client", Version: "1.9.0"})
+	assert.Nil(t, err, "could not update package")
+	assert.Equal(t, pkg.ID, updated.ID)
+
+	packages, err := c.Packages().List(ctx, "test-entity")
+	assert.Nil(t, err, "could not list packages")
+	assert.Len(t, packages, 2)
+	assert.Equal(t, "1.9.0", packages[1].Version)
+
+	assert.Nil(t, server.SeedPackage("test-entity", cortex.Package{PackageType: cortex.PackageTypeNuGet, Name: "Newtonsoft.Json", Version: "13.0.1"}))
+	assert.Nil(t, c.Packages().Delete(ctx, "test-entity", cortex.PackageTypeNuGet, "Newtonsoft.Json"), "could not delete package")
+	err = c.Packages().Delete(ctx, "test-entity", cortex.PackageTypeNuGet, "Newtonsoft.Json")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	assert.Nil(t, c.CatalogEntities().Delete(ctx, "test-entity"), "could not delete catalog entity")
+	_, err = c.CatalogEntities().Upsert(ctx, cortex.UpsertCatalogEntityRequest{
+		Info: cortex.CatalogEntityData{Tag: "test-entity", Title: "Test Entity", Type: "service"},
+	})
+	assert.Nil(t, err, "could not upsert catalog entity")
+	packages, err = c.Packages().List(ctx, "test-entity")
+	assert.Nil(t, err, "could not list packages")
+	assert.Empty(t, packages)
+}
+
+func TestServerDependencyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+	params := cortex.DependencyParams{Method: "GET", Path: "/v1/products"}
+
+	_, err := c.Dependencies().Create(ctx, "test-caller", "test-callee", params, cortex.UpsertDependencyRequest{})
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	for _, tag := range []string{"test-caller", "test-callee"} {
+		_, err = c.CatalogEntities().Upsert(ctx, cortex.UpsertCatalogEntityRequest{
+			Info: cortex.CatalogEntityData{Tag: tag, Title: tag, Type: "service"},
+		})
+		assert.Nil(t, err, "could not upsert catalog entity")
+	}
+
+	_, err = c.Dependencies().Create(ctx, "test-caller", "test-callee", params, cortex.UpsertDependencyRequest{Description: "Lists products"})
+	assert.Nil(t, err, "could not create dependency")
+	_, err = c.Dependencies().Create(ctx, "test-caller", "test-callee", params, cortex.UpsertDependencyRequest{})
+	assert.ErrorIs(t, err, cortex.ApiErrorConflict)
+	_, err = c.Dependencies().Get(ctx, "test-caller", "test-callee", cortex.DependencyParams{})
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+
+	_, err = c.Dependencies().Update(ctx, "test-caller", "test-callee", params, cortex.UpsertDependencyRequest{
+		Metadata: map[string]interface{}{"tier": "critical"},
+	})
+	assert.Nil(t, err, "could not update dependency")
+	dependency, err := c.Dependencies().Get(ctx, "test-caller", "test-callee", params)
+	assert.Nil(t, err, "could not get dependency")
+	assert.Empty(t, dependency.Description)
+	assert.Equal(t, "critical", dependency.Metadata["tier"])
+
+	assert.Nil(t, c.CatalogEntities().Delete(ctx, "test-callee"), "could not delete catalog entity")
+	_, err = c.Dependencies().Get(ctx, "test-caller", "test-callee", params)
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
+
+func TestServerRelationshipTypeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c, _ := buildClient(t, cortextest.Token)
+
+	relationshipType, err := c.RelationshipTypes().Create(ctx, cortex.CreateRelationshipTypeRequest{
+		Tag: "runs-on",
+		UpdateRelationshipTypeRequest: cortex.UpdateRelationshipTypeRequest{
+			Name:          "Runs on",
+			SourcesFilter: cortex.NewRelationshipTypeFilter([]string{"service"}),
+		},
+	})
+	assert.Nil(t, err, "could not create relationship type")
+	assert.Equal(t, cortex.RelationshipTypeDefinitionLocationSource, relationshipType.DefinitionLocation)
+	assert.Equal(t, cortex.RelationshipTypeFilterAll, relationshipType.DestinationsFilter.Type)
+
+	_, err = c.RelationshipTypes().Create(ctx, cortex.CreateRelationshipTypeRequest{Tag: "runs-on", UpdateRelationshipTypeRequest: cortex.UpdateRelationshipTypeRequest{Name: "Runs on"}})
+	assert.ErrorIs(t, err, cortex.ApiErrorConflict)
+
+	_, err = c.RelationshipTypes().Update(ctx, "runs-on", cortex.UpdateRelationshipTypeRequest{Name: "Runs on", AllowCycles: true})
+	assert.Nil(t, err, "could not update relationship type")
+	relationshipType, err = c.RelationshipTypes().Get(ctx, "runs-on")
+	assert.Nil(t, err, "could not get relationship type")
+	assert.True(t, relationshipType.AllowCycles)
+	assert.Nil(t, relationshipType.SourcesFilter.EntityTypes())
+
+	assert.Nil(t, c.RelationshipTypes().Delete(ctx, "runs-on"), "could not delete relationship type")
+	_, err = c.RelationshipTypes().Get(ctx, "runs-on")
+	assert.ErrorIs(t, err, cortex.ApiErrorNotFound)
+}
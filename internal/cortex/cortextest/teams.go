@@ -0,0 +1,162 @@
+package cortextest
+
+import (
+	"net/http"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+/***********************************************************************************************************************
+ * Seeding
+ **********************************************************************************************************************/
+
+// SeedTeam stores a team as if it had been created through POST /api/v1/teams.
+func (s *Server) SeedTeam(team cortex.Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.teams[team.TeamTag] = team
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/teams
+ **********************************************************************************************************************/
+
+func (s *Server) listTeams(w http.ResponseWriter, req *http.Request, _ []string) {
+	teams := []cortex.Team{}
+	for _, tag := range sortedKeys(s.teams) {
+		teams = append(teams, s.teams[tag])
+	}
+
+	page, pageSize, err := pageParams(req)
+	if err != nil {
+		s.writeBadRequest(w, err.Error())
+		return
+	}
+	items, totalPages := paginate(teams, page, pageSize)
+	s.writeJSON(w, http.StatusOK, cortex.TeamsResponse{
+		Teams:      items,
+		Page:       page,
+		Total:      len(teams),
+		TotalPages: totalPages,
+	})
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/teams/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) getTeam(w http.ResponseWriter, _ *http.Request, params []string) {
+	team, ok := s.teams[params[0]]
+	if !ok {
+		s.writeNotFound(w, "team", params[0])
+		return
+	}
+	s.writeJSON(w, http.StatusOK, team)
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/teams
+ **********************************************************************************************************************/
+
+func (s *Server) createTeam(w http.ResponseWriter, req *http.Request, _ []string) {
+	body := cortex.CreateTeamRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	if body.TeamTag == "" {
+		s.writeBadRequest(w, "teamTag is required")
+		return
+	}
+	if _, ok := s.teams[body.TeamTag]; ok {
+		s.writeConflict(w, "team", body.TeamTag)
+		return
+	}
+	team := cortex.Team{
+		AdditionalMembers: body.AdditionalMembers,
+		IsArchived:        body.IsArchived,
+		Metadata:          body.Metadata,
+		SlackChannels:     body.SlackChannels,
+		Links:             body.Links,
+		TeamTag:           body.TeamTag,
+		Type:              body.Type,
+		CortexTeam:        body.CortexTeam,
+		IdpGroup:          body.IdpGroup,
+	}
+	if team.Type == "" {
+		team.Type = cortex.TeamTypeCortex
+	}
+	s.teams[team.TeamTag] = team
+	s.writeJSON(w, http.StatusOK, team)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/teams/:tag
+ **********************************************************************************************************************/
+
+func (s *Server) updateTeam(w http.ResponseWriter, req *http.Request, params []string) {
+	team, ok := s.teams[params[0]]
+	if !ok {
+		s.writeNotFound(w, "team", params[0])
+		return
+	}
+	if team.IsArchived {
+		s.writeBadRequest(w, "team "+params[0]+" is archived")
+		return
+	}
+	body := cortex.UpdateTeamRequest{}
+	if err := decodeJSON(req, &body); err != nil {
+		s.writeBadRequest(w, "could not parse request: "+err.Error())
+		return
+	}
+	team.Metadata = body.Metadata
+	team.Links = body.Links
+	team.SlackChannels = body.SlackChannels
+	team.AdditionalMembers = body.AdditionalMembers
+	if body.Type != "" {
+		team.Type = body.Type
+	}
+	if body.CortexTeam != nil || body.IdpGroup != nil {
+		team.CortexTeam = body.CortexTeam
+		team.IdpGroup = body.IdpGroup
+	}
+	s.teams[team.TeamTag] = team
+	s.writeJSON(w, http.StatusOK, team)
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/teams?teamTag=
+ **********************************************************************************************************************/
+
+func (s *Server) deleteTeam(w http.ResponseWriter, req *http.Request, _ []string) {
+	tag := req.URL.Query().Get("teamTag")
+	if _, ok := s.teams[tag]; !ok {
+		s.writeNotFound(w, "team", tag)
+		return
+	}
+	delete(s.teams, tag)
+	w.WriteHeader(http.StatusOK)
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/teams/:tag/archive, PUT /api/v1/teams/:tag/unarchive
+ **********************************************************************************************************************/
+
+func (s *Server) archiveTeam(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.setTeamArchived(w, params[0], true)
+}
+
+func (s *Server) unarchiveTeam(w http.ResponseWriter, _ *http.Request, params []string) {
+	s.setTeamArchived(w, params[0], false)
+}
+
+func (s *Server) setTeamArchived(w http.ResponseWriter, tag string, archived bool) {
+	team, ok := s.teams[tag]
+	if !ok {
+		s.writeNotFound(w, "team", tag)
+		return
+	}
+	team.IsArchived = archived
+	s.teams[tag] = team
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,110 @@
+package cortex
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DebugLogSubsystem is the tflog subsystem that HTTP requests to the Cortex API are logged under. Its level can be
+	// set independently of the provider with TF_LOG_PROVIDER_CORTEX_HTTP.
+	DebugLogSubsystem = "http"
+
+	debugLogLevelEnvVar = "TF_LOG_PROVIDER_CORTEX_HTTP"
+	redactedValue       = "***"
+)
+
+// defaultSensitiveHeaders are always redacted from debug logs, in addition to any passed to WithSensitiveHeaders.
+var defaultSensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// debugTransport is an http.RoundTripper that logs every attempt of a request through tflog. Header values listed in
+// sensitiveHeaders are redacted, and the API token is masked from every message and field, bodies included.
+type debugTransport struct {
+	next             http.RoundTripper
+	token            string
+	sensitiveHeaders map[string]bool
+	logBodies        bool
+}
+
+func newDebugTransport(next http.RoundTripper, token string, sensitiveHeaders []string, logBodies bool) *debugTransport {
+	headers := map[string]bool{}
+	for _, h := range append(defaultSensitiveHeaders, sensitiveHeaders...) {
+		headers[http.CanonicalHeaderKey(h)] = true
+	}
+	return &debugTransport{
+		next:             next,
+		token:            token,
+		sensitiveHeaders: headers,
+		logBodies:        logBodies,
+	}
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), DebugLogSubsystem, tflog.WithLevelFromEnv(debugLogLevelEnvVar))
+	if t.token != "" {
+		ctx = tflog.SubsystemMaskLogStrings(ctx, DebugLogSubsystem, t.token)
+	}
+
+	fields := map[string]interface{}{
+		"http_method":          req.Method,
+		"http_url":             req.URL.String(),
+		"http_request_headers": t.redactHeaders(req.Header),
+	}
+	if t.logBodies && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			b, _ := io.ReadAll(body)
+			_ = body.Close()
+			fields["http_request_body"] = string(b)
+		}
+	}
+
+	start := time.Now()
+	response, err := t.next.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, DebugLogSubsystem, "Cortex API request failed", fields)
+		return nil, err
+	}
+
+	fields["http_status"] = response.StatusCode
+	fields["http_response_headers"] = t.redactHeaders(response.Header)
+	if requestId := response.Header.Get("X-Request-Id"); requestId != "" {
+		fields["request_id"] = requestId
+	}
+	if t.logBodies && response.Body != nil {
+		b, readErr := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(b))
+		if readErr != nil {
+			tflog.SubsystemDebug(ctx, DebugLogSubsystem, "Cortex API request failed", fields)
+			return nil, readErr
+		}
+		fields["http_response_body"] = string(b)
+	}
+	tflog.SubsystemDebug(ctx, DebugLogSubsystem, "Cortex API request", fields)
+	return response, nil
+}
+
+// redactHeaders flattens headers into a loggable map, replacing the values of sensitive headers.
+func (t *debugTransport) redactHeaders(headers http.Header) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for k, v := range headers {
+		if t.sensitiveHeaders[http.CanonicalHeaderKey(k)] {
+			redacted[k] = redactedValue
+			continue
+		}
+		redacted[k] = strings.Join(v, ", ")
+	}
+	return redacted
+}
@@ -0,0 +1,75 @@
+package cortex_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const debugTestToken = "super-secret-token"
+
+func buildDebugClient(t *testing.T, opts ...cortex.OptionDelegator) *cortex.HttpClient {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("X-Request-Id", "test-request-id")
+		w.Header().Set("X-Session", "test-session")
+		// Echo the token back to make sure response bodies are masked too.
+		_, _ = w.Write([]byte(`{"teamTag":"test-team","metadata":{"name":"` + debugTestToken + `"}}`))
+	}))
+	t.Cleanup(ts.Close)
+
+	opts = append([]cortex.OptionDelegator{
+		cortex.WithURL(ts.URL),
+		cortex.WithToken(debugTestToken),
+		cortex.WithVersion("test"),
+	}, opts...)
+	c, err := cortex.NewClient(opts...)
+	assert.Nil(t, err, "could not build client")
+	return c
+}
+
+func TestDebugLoggingRedactsToken(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := buildDebugClient(t, cortex.WithDebugBodies(true), cortex.WithSensitiveHeaders("x-session"))
+	_, err := c.Teams().Get(ctx, "test-team")
+	assert.Nil(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.Nil(t, err, "could not decode log output")
+	assert.Len(t, entries, 1)
+	assert.NotContains(t, output.String(), debugTestToken)
+
+	entry := entries[0]
+	assert.Equal(t, "Cortex API request", entry["@message"])
+	assert.Equal(t, http.MethodGet, entry["http_method"])
+	assert.Equal(t, float64(http.StatusOK), entry["http_status"])
+	assert.Equal(t, "test-request-id", entry["request_id"])
+	assert.Contains(t, entry["http_url"], "/api/v1/teams/test-team")
+	assert.Contains(t, entry["http_response_body"], "***")
+
+	requestHeaders := entry["http_request_headers"].(map[string]interface{})
+	assert.Equal(t, "***", requestHeaders["Authorization"])
+	responseHeaders := entry["http_response_headers"].(map[string]interface{})
+	assert.Equal(t, "***", responseHeaders["X-Session"])
+}
+
+func TestDebugLoggingOmitsBodiesByDefault(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := buildDebugClient(t, cortex.WithDebugBodies(false))
+	_, err := c.Teams().Get(ctx, "test-team")
+	assert.Nil(t, err)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.Nil(t, err, "could not decode log output")
+	assert.Len(t, entries, 1)
+	assert.NotContains(t, entries[0], "http_response_body")
+	assert.NotContains(t, entries[0], "http_request_body")
+}
@@ -20,8 +20,8 @@ type DepartmentsClient struct {
 
 var _ DepartmentsClientInterface = &DepartmentsClient{}
 
-func (c *DepartmentsClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *DepartmentsClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
 /***********************************************************************************************************************
@@ -61,14 +61,14 @@ func (c *DepartmentsClient) Get(ctx context.Context, tag string) (Department, er
 	params := DepartmentGetParams{
 		DepartmentTag: tag,
 	}
-	body, err := c.Client().Get(Route("departments", "")).QueryStruct(&params).Receive(&department, &apiError)
+	body, err := c.Client(ctx).Get(Route("departments", "")).QueryStruct(&params).Receive(&department, &apiError)
 	if err != nil {
 		return department, fmt.Errorf("failed getting department: %+v", err)
 	}
 
 	err = c.client.handleResponseStatus(body, &apiError)
 	if err != nil {
-		return department, fmt.Errorf("failed getting department: %+v", err)
+		return department, fmt.Errorf("failed getting department: %w", err)
 	}
 	return department, nil
 }
@@ -98,7 +98,7 @@ func (c *DepartmentsClient) Create(ctx context.Context, req CreateDepartmentRequ
 	department := Department{}
 	apiError := ApiError{}
 
-	body, err := c.Client().Post(Route("departments", "")).BodyJSON(&req).Receive(&department, &apiError)
+	body, err := c.Client(ctx).Post(Route("departments", "")).BodyJSON(&req).Receive(&department, &apiError)
 	if err != nil {
 		return department, fmt.Errorf("failed creating department: %+v", err)
 	}
@@ -134,7 +134,7 @@ func (c *DepartmentsClient) Update(ctx context.Context, tag string, req UpdateDe
 	department := Department{}
 	apiError := ApiError{}
 
-	body, err := c.Client().Put(Route("departments", tag)).BodyJSON(&req).Receive(&department, &apiError)
+	body, err := c.Client(ctx).Put(Route("departments", tag)).BodyJSON(&req).Receive(&department, &apiError)
 	if err != nil {
 		return department, errors.New("could not update department: " + err.Error())
 	}
@@ -163,7 +163,7 @@ func (c *DepartmentsClient) Delete(ctx context.Context, tag string) error {
 		DepartmentTag: tag,
 	}
 
-	body, err := c.Client().Delete(Route("departments", "")).QueryStruct(&params).Receive(&response, &apiError)
+	body, err := c.Client(ctx).Delete(Route("departments", "")).QueryStruct(&params).Receive(&response, &apiError)
 	if err != nil {
 		return errors.New("could not delete department: " + err.Error())
 	}
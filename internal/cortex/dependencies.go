@@ -0,0 +1,145 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+)
+
+type DependenciesClientInterface interface {
+	Get(ctx context.Context, callerTag string, calleeTag string, params DependencyParams) (Dependency, error)
+	Create(ctx context.Context, callerTag string, calleeTag string, params DependencyParams, req UpsertDependencyRequest) (Dependency, error)
+	Update(ctx context.Context, callerTag string, calleeTag string, params DependencyParams, req UpsertDependencyRequest) (Dependency, error)
+	Delete(ctx context.Context, callerTag string, calleeTag string, params DependencyParams) error
+}
+
+type DependenciesClient struct {
+	client *HttpClient
+}
+
+var _ DependenciesClientInterface = &DependenciesClient{}
+
+func (c *DependenciesClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// Dependency is an edge from a caller entity to a callee entity, optionally on one endpoint of the callee. Unlike
+// CatalogEntityDependency, it is managed through the dependency endpoints rather than the caller's descriptor.
+type Dependency struct {
+	CallerTag   string                 `json:"callerTag"`
+	CalleeTag   string                 `json:"calleeTag"`
+	Method      string                 `json:"method,omitempty"`
+	Path        string                 `json:"path,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// DependencyParams identify the endpoint of the callee that a dependency is on. Both are empty for dependencies on the
+// callee as a whole.
+type DependencyParams struct {
+	Method string `url:"method,omitempty"`
+	Path   string `url:"path,omitempty"`
+}
+
+// ID identifies a dependency by its caller, callee and, for dependencies on an endpoint, its method and path.
+func (d *Dependency) ID() string {
+	if d.Method == "" && d.Path == "" {
+		return d.CallerTag + ":" + d.CalleeTag
+	}
+	return d.CallerTag + ":" + d.CalleeTag + ":" + d.Method + ":" + d.Path
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (c *DependenciesClient) Get(ctx context.Context, callerTag string, calleeTag string, params DependencyParams) (Dependency, error) {
+	dependency := Dependency{}
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Get(Route("catalog_entities", callerTag+"/dependencies/"+calleeTag)).QueryStruct(&params).Receive(&dependency, &apiError)
+	if err != nil {
+		return dependency, errors.New("could not get dependency: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+type UpsertDependencyRequest struct {
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// Create adds a dependency from the caller to the callee. It fails with ApiErrorConflict if the dependency exists.
+func (c *DependenciesClient) Create(ctx context.Context, callerTag string, calleeTag string, params DependencyParams, req UpsertDependencyRequest) (Dependency, error) {
+	dependency := Dependency{}
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Post(Route("catalog_entities", callerTag+"/dependencies/"+calleeTag)).QueryStruct(&params).BodyJSON(&req).Receive(&dependency, &apiError)
+	if err != nil {
+		return dependency, fmt.Errorf("failed creating dependency: %+v", err)
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+// Update sets the description and metadata of an existing dependency.
+func (c *DependenciesClient) Update(ctx context.Context, callerTag string, calleeTag string, params DependencyParams, req UpsertDependencyRequest) (Dependency, error) {
+	dependency := Dependency{}
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Put(Route("catalog_entities", callerTag+"/dependencies/"+calleeTag)).QueryStruct(&params).BodyJSON(&req).Receive(&dependency, &apiError)
+	if err != nil {
+		return dependency, fmt.Errorf("failed updating dependency: %+v", err)
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return dependency, err
+	}
+
+	return dependency, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:callerTag/dependencies/:calleeTag
+ **********************************************************************************************************************/
+
+func (c *DependenciesClient) Delete(ctx context.Context, callerTag string, calleeTag string, params DependencyParams) error {
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Delete(Route("catalog_entities", callerTag+"/dependencies/"+calleeTag)).QueryStruct(&params).Receive(nil, &apiError)
+	if err != nil {
+		return errors.New("could not delete dependency: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testDependency = cortex.Dependency{
+	CallerTag:   "test-caller",
+	CalleeTag:   "test-callee",
+	Method:      "GET",
+	Path:        "/v1/products",
+	Description: "Lists products",
+	Metadata:    map[string]interface{}{"tier": "critical"},
+}
+
+var testDependencyParams = cortex.DependencyParams{Method: "GET", Path: "/v1/products"}
+
+func TestGetDependency(t *testing.T) {
+	route := cortex.Route("catalog_entities", "test-caller/dependencies/test-callee")
+	c, teardown, err := setupClient(
+		route,
+		testDependency,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, route+"?method=GET&path=%2Fv1%2Fproducts"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Dependencies().Get(context.Background(), "test-caller", "test-callee", testDependencyParams)
+	assert.Nil(t, err, "error getting dependency")
+	assert.Equal(t, testDependency, res)
+}
+
+func TestCreateDependency(t *testing.T) {
+	req := cortex.UpsertDependencyRequest{
+		Description: testDependency.Description,
+		Metadata:    testDependency.Metadata,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		testDependency,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Dependencies().Create(context.Background(), "test-caller", "test-callee", testDependencyParams, req)
+	assert.Nil(t, err, "error creating dependency")
+	assert.Equal(t, testDependency, res)
+}
+
+func TestUpdateDependency(t *testing.T) {
+	req := cortex.UpsertDependencyRequest{Description: "Lists every product"}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", "test-caller/dependencies/test-callee"),
+		testDependency,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.Dependencies().Update(context.Background(), "test-caller", "test-callee", testDependencyParams, req)
+	assert.Nil(t, err, "error updating dependency")
+}
+
+func TestDeleteDependency(t *testing.T) {
+	route := cortex.Route("catalog_entities", "test-caller/dependencies/test-callee")
+	c, teardown, err := setupClient(
+		route,
+		nil,
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestURI(t, route),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Dependencies().Delete(context.Background(), "test-caller", "test-callee", cortex.DependencyParams{})
+	assert.Nil(t, err, "error deleting dependency")
+}
+
+func TestDependencyID(t *testing.T) {
+	assert.Equal(t, "test-caller:test-callee:GET:/v1/products", testDependency.ID())
+	assert.Equal(t, "test-caller:test-callee", (&cortex.Dependency{CallerTag: "test-caller", CalleeTag: "test-callee"}).ID())
+}
@@ -0,0 +1,218 @@
+package cortex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// descriptorReader reads typed values out of a decoded YAML descriptor. Instead of panicking on a value of the wrong
+// type, it records a path-qualified error (e.g. "info.x-cortex-oncall.pagerduty.id: expected string, got int") and
+// returns the zero value, so that every problem in a descriptor is reported at once. Missing and null values are not
+// errors.
+type descriptorReader struct {
+	errs []error
+}
+
+// Err returns every error recorded so far, joined, or nil.
+func (r *descriptorReader) Err() error {
+	return errors.Join(r.errs...)
+}
+
+func (r *descriptorReader) typeError(path string, expected string, value interface{}) {
+	r.errs = append(r.errs, fmt.Errorf("%s: expected %s, got %s", path, expected, yamlTypeName(value)))
+}
+
+func yamlTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "int"
+	case float32, float64:
+		return "float"
+	case map[string]interface{}, map[interface{}]interface{}:
+		return "map"
+	case []interface{}:
+		return "list"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func fieldPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+/***********************************************************************************************************************
+ * Values
+ **********************************************************************************************************************/
+
+func (r *descriptorReader) asMap(value interface{}, path string) (map[string]interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		r.typeError(path, "map", value)
+	}
+	return m, ok
+}
+
+func (r *descriptorReader) asList(value interface{}, path string) ([]interface{}, bool) {
+	if value == nil {
+		return nil, false
+	}
+	l, ok := value.([]interface{})
+	if !ok {
+		r.typeError(path, "list", value)
+	}
+	return l, ok
+}
+
+func (r *descriptorReader) asString(value interface{}, path string) string {
+	if value == nil {
+		return ""
+	}
+	s, ok := value.(string)
+	if !ok {
+		r.typeError(path, "string", value)
+	}
+	return s
+}
+
+func (r *descriptorReader) asBool(value interface{}, path string) bool {
+	if value == nil {
+		return false
+	}
+	b, ok := value.(bool)
+	if !ok {
+		r.typeError(path, "bool", value)
+	}
+	return b
+}
+
+func (r *descriptorReader) asInt64(value interface{}, path string) int64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) <= math.MaxInt64 {
+			return int64(v)
+		}
+	}
+	r.typeError(path, "int", value)
+	return 0
+}
+
+func (r *descriptorReader) asFloat64(value interface{}, path string) float64 {
+	switch v := value.(type) {
+	case nil:
+		return 0
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	r.typeError(path, "float", value)
+	return 0
+}
+
+// asNumericFloat64 reads a float like asFloat64, but also accepts strings holding a number, such as slo: "99.9".
+func (r *descriptorReader) asNumericFloat64(value interface{}, path string) float64 {
+	if v, ok := value.(string); ok {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			r.typeError(path, "float", value)
+		}
+		return f
+	}
+	return r.asFloat64(value, path)
+}
+
+/***********************************************************************************************************************
+ * Fields
+ **********************************************************************************************************************/
+
+func (r *descriptorReader) mapField(m map[string]interface{}, path string, key string) (map[string]interface{}, bool) {
+	return r.asMap(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) listField(m map[string]interface{}, path string, key string) ([]interface{}, bool) {
+	return r.asList(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) stringField(m map[string]interface{}, path string, key string) string {
+	return r.asString(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) boolField(m map[string]interface{}, path string, key string) bool {
+	return r.asBool(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) int64Field(m map[string]interface{}, path string, key string) int64 {
+	return r.asInt64(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) float64Field(m map[string]interface{}, path string, key string) float64 {
+	return r.asFloat64(m[key], fieldPath(path, key))
+}
+
+func (r *descriptorReader) numericFloat64Field(m map[string]interface{}, path string, key string) float64 {
+	return r.asNumericFloat64(m[key], fieldPath(path, key))
+}
+
+// stringListField reads a list of strings, skipping items that are not strings. It returns nil when there is no list.
+func (r *descriptorReader) stringListField(m map[string]interface{}, path string, key string) []string {
+	items, ok := r.listField(m, path, key)
+	if !ok {
+		return nil
+	}
+	values := []string{}
+	for i, item := range items {
+		itemPath := indexPath(fieldPath(path, key), i)
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		} else {
+			r.typeError(itemPath, "string", item)
+		}
+	}
+	return values
+}
+
+// eachMapField calls fn with every map in the list at key, skipping items that are not maps.
+func (r *descriptorReader) eachMapField(m map[string]interface{}, path string, key string, fn func(item map[string]interface{}, itemPath string)) {
+	listPath := fieldPath(path, key)
+	items, _ := r.asList(m[key], listPath)
+	for i, item := range items {
+		itemPath := indexPath(listPath, i)
+		if itemMap, ok := r.asMap(item, itemPath); ok {
+			fn(itemMap, itemPath)
+		} else if item == nil {
+			r.typeError(itemPath, "map", item)
+		}
+	}
+}
@@ -5,11 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dghubble/sling"
-	"github.com/motemen/go-loghttp"
-	_ "github.com/motemen/go-loghttp/global" // Just this line!
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 )
 
 const (
@@ -24,6 +23,7 @@ var BaseUris = map[string]string{
 	"catalog_entities":     "/api/v1/catalog/",
 	"open_api":             "/api/v1/open-api",
 	"resource_definitions": "/api/v1/catalog/definitions/",
+	"relationship_types":   "/api/v1/relationship-types/",
 }
 
 func Route(domain string, path string) string {
@@ -35,34 +35,64 @@ func Route(domain string, path string) string {
 }
 
 type HttpClient struct {
-	ctx        context.Context
-	client     *sling.Sling
-	yamlClient *sling.Sling
-	baseUrl    string
-	token      string
-	version    string
+	ctx            context.Context
+	httpClient     *http.Client
+	client         *sling.Sling
+	yamlClient     *sling.Sling
+	baseUrl        string
+	token          string
+	version        string
+	maxRetries     int
+	retryMaxWait   time.Duration
+	requestTimeout time.Duration
+	limiter        *rateLimiter
+
+	sensitiveHeaders []string
+	logBodies        bool
+	transport        http.RoundTripper
 }
 
 type OptionDelegator func(c *HttpClient) error
 
 // NewClient initializes a new API client for Cortex.
 func NewClient(opts ...OptionDelegator) (*HttpClient, error) {
-	c := &HttpClient{}
+	c := &HttpClient{
+		maxRetries:   DefaultMaxRetries,
+		retryMaxWait: DefaultRetryMaxWait,
+		logBodies:    os.Getenv("HTTP_DEBUG") == "1",
+	}
 	for _, f := range opts {
 		if err := f(c); err != nil {
 			return nil, err
 		}
 	}
 
-	hc := &http.Client{}
-	if os.Getenv("HTTP_DEBUG") == "1" {
-		hc.Transport = &loghttp.Transport{}
+	httpClient := &http.Client{}
+	if c.httpClient != nil {
+		clientCopy := *c.httpClient
+		httpClient = &clientCopy
+	}
+	var transport = http.DefaultTransport
+	if c.transport != nil {
+		transport = c.transport
+	} else if httpClient.Transport != nil {
+		transport = httpClient.Transport
+	}
+	transport = newDebugTransport(transport, c.token, c.sensitiveHeaders, c.logBodies)
+	if c.requestTimeout > 0 {
+		transport = &timeoutTransport{next: transport, timeout: c.requestTimeout}
+	}
+	if c.limiter != nil {
+		// Rate limit underneath the retries, so that every attempt draws from the shared budget.
+		transport = &rateLimitTransport{next: transport, limiter: c.limiter}
 	}
-	c.client = sling.New().Doer(hc).Base(c.baseUrl).
+	httpClient.Transport = newRetryTransport(transport, c.maxRetries, c.retryMaxWait)
+	c.httpClient = httpClient
+	c.client = sling.New().Doer(c.httpClient).Base(c.baseUrl).
 		Set("User-Agent", fmt.Sprintf("%s (%s)", UserAgentPrefix, c.version)).
 		Set("Authorization", fmt.Sprintf("Bearer %s", c.token)).
 		ResponseDecoder(jsonDecoder{})
-	c.yamlClient = sling.New().Doer(hc).Base(c.baseUrl).
+	c.yamlClient = sling.New().Doer(c.httpClient).Base(c.baseUrl).
 		Set("User-Agent", fmt.Sprintf("%s (%s)", UserAgentPrefix, c.version)).
 		Set("Authorization", fmt.Sprintf("Bearer %s", c.token)).
 		ResponseDecoder(yamlDecoder{})
@@ -80,7 +110,7 @@ func WithVersion(version string) func(*HttpClient) error {
 	}
 }
 
-// WithContext Specify the context for the cortex client to use.
+// WithContext Specify the fallback context for the cortex client to use when a call does not provide one.
 func WithContext(ctx context.Context) func(*HttpClient) error {
 	return func(c *HttpClient) error {
 		c.ctx = ctx
@@ -113,34 +143,147 @@ func WithToken(token string) func(*HttpClient) error {
 	}
 }
 
+// WithMaxRetries Specify how many times a rate-limited or failed idempotent request is retried. Zero disables retries.
+func WithMaxRetries(maxRetries int) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if maxRetries < 0 {
+			return errors.New("cannot specify negative max retries")
+		}
+		c.maxRetries = maxRetries
+		return nil
+	}
+}
+
+// WithRetryMaxWait Specify the longest the client will wait between two attempts of the same request.
+func WithRetryMaxWait(maxWait time.Duration) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if maxWait <= 0 {
+			return errors.New("retry max wait must be greater than zero")
+		}
+		c.retryMaxWait = maxWait
+		return nil
+	}
+}
+
+// WithRequestsPerSecond Limit the rate of requests issued by the client and all of its sub-clients. Zero disables
+// rate limiting.
+func WithRequestsPerSecond(requestsPerSecond float64) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if requestsPerSecond < 0 {
+			return errors.New("cannot specify negative requests per second")
+		}
+		if requestsPerSecond == 0 {
+			c.limiter = nil
+			return nil
+		}
+		c.limiter = newRateLimiter(requestsPerSecond)
+		return nil
+	}
+}
+
+// WithRequestTimeout Specify how long a single attempt of a request may take, including reading the response body.
+// Zero disables the timeout.
+func WithRequestTimeout(timeout time.Duration) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if timeout < 0 {
+			return errors.New("cannot specify negative request timeout")
+		}
+		c.requestTimeout = timeout
+		return nil
+	}
+}
+
+// WithHTTPClient Specify the http.Client to send requests with. Its transport is wrapped with the client's retry, rate
+// limiting and logging transports.
+func WithHTTPClient(httpClient *http.Client) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if httpClient == nil {
+			return errors.New("cannot specify nil http client")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport Specify the http.RoundTripper that sends requests, in place of http.DefaultTransport or the
+// transport of the client given to WithHTTPClient.
+func WithTransport(transport http.RoundTripper) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		if transport == nil {
+			return errors.New("cannot specify nil transport")
+		}
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithSensitiveHeaders Specify additional headers whose values are redacted from debug logs. The Authorization header
+// is always redacted.
+func WithSensitiveHeaders(headers ...string) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		c.sensitiveHeaders = append(c.sensitiveHeaders, headers...)
+		return nil
+	}
+}
+
+// WithDebugBodies Specify whether request and response bodies are included in debug logs. Defaults to true when the
+// HTTP_DEBUG environment variable is set to 1.
+func WithDebugBodies(logBodies bool) func(*HttpClient) error {
+	return func(c *HttpClient) error {
+		c.logBodies = logBodies
+		return nil
+	}
+}
+
+// handleResponseStatus returns apiError, filled in with the response status, when the response is not a 2xx.
 func (c *HttpClient) handleResponseStatus(response *http.Response, apiError *ApiError) error {
-	switch code := response.StatusCode; {
-	case code >= 200 && code <= 299:
+	if response.StatusCode >= 200 && response.StatusCode <= 299 {
 		return nil
-	case code == 404:
-		return ApiErrorNotFound
-	case code == 401:
-		return fmt.Errorf("%s\n%s", ApiErrorUnauthorized, apiError)
-	default:
-		return fmt.Errorf("%d request failed with error: %+v", code, apiError.String())
 	}
+	if apiError == nil {
+		apiError = &ApiError{}
+	}
+	apiError.HttpStatus = response.StatusCode
+	return apiError
 }
 
 func (c *HttpClient) Ping(ctx context.Context) error {
 	apiError := new(ApiError)
-	response, err := c.client.Get("/").Receive(nil, apiError)
+	response, err := c.Client(ctx).Get("/").Receive(nil, apiError)
 	if err != nil {
 		return err
 	}
 	return c.handleResponseStatus(response, apiError)
 }
 
-func (c *HttpClient) Client() *sling.Sling {
-	return c.client.New()
+// Client returns a JSON request builder whose requests are bound to ctx, so they are aborted when ctx is cancelled.
+func (c *HttpClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.New().Doer(c.doer(ctx))
+}
+
+// YamlClient returns a YAML request builder whose requests are bound to ctx.
+func (c *HttpClient) YamlClient(ctx context.Context) *sling.Sling {
+	return c.yamlClient.New().Doer(c.doer(ctx))
+}
+
+func (c *HttpClient) doer(ctx context.Context) sling.Doer {
+	if ctx == nil {
+		ctx = c.ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &contextDoer{ctx: ctx, client: c.httpClient}
+}
+
+// contextDoer attaches a per-call context to every request it sends.
+type contextDoer struct {
+	ctx    context.Context
+	client *http.Client
 }
 
-func (c *HttpClient) YamlClient() *sling.Sling {
-	return c.yamlClient.New()
+func (d *contextDoer) Do(req *http.Request) (*http.Response, error) {
+	return d.client.Do(req.WithContext(d.ctx))
 }
 
 /********** Client Interfaces **********/
@@ -153,6 +296,14 @@ func (c *HttpClient) CatalogEntityCustomData() CatalogEntityCustomDataClientInte
 	return &CatalogEntityCustomDataClient{client: c}
 }
 
+func (c *HttpClient) Packages() PackagesClientInterface {
+	return &PackagesClient{client: c}
+}
+
+func (c *HttpClient) Dependencies() DependenciesClientInterface {
+	return &DependenciesClient{client: c}
+}
+
 func (c *HttpClient) Teams() TeamsClientInterface {
 	return &TeamsClient{client: c}
 }
@@ -165,6 +316,10 @@ func (c *HttpClient) Scorecards() ScorecardsClientInterface {
 	return &ScorecardsClient{client: c}
 }
 
+func (c *HttpClient) RelationshipTypes() RelationshipTypesClientInterface {
+	return &RelationshipTypesClient{client: c}
+}
+
 func (c *HttpClient) ResourceDefinitions() ResourceDefinitionsClientInterface {
 	return &ResourceDefinitionsClient{client: c}
 }
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 type RequestTest func(req *http.Request)
@@ -95,6 +96,54 @@ func TestClientInitialization(t *testing.T) {
 	assert.Equal(t, expectedAuthString, token, "Expected auth string to be %s, got %s", expectedAuthString, token)
 }
 
+// stallingServer returns a server that never answers until the test finishes.
+func stallingServer(t *testing.T) *httptest.Server {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-done:
+		}
+	}))
+	t.Cleanup(func() {
+		close(done)
+		ts.Close()
+	})
+	return ts
+}
+
+func TestClientHonorsContextCancellation(t *testing.T) {
+	ts := stallingServer(t)
+	c, err := cortex.NewClient(
+		cortex.WithURL(ts.URL),
+		cortex.WithToken("test"),
+	)
+	assert.Nil(t, err, "received error initializing API client")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = c.Teams().Get(ctx, "test-team")
+	assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	assert.Less(t, time.Since(start), 2*time.Second, "expected the request to be aborted when the context expired")
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	ts := stallingServer(t)
+	c, err := cortex.NewClient(
+		cortex.WithURL(ts.URL),
+		cortex.WithToken("test"),
+		cortex.WithRequestTimeout(50*time.Millisecond),
+	)
+	assert.Nil(t, err, "received error initializing API client")
+
+	start := time.Now()
+	err = c.Ping(context.Background())
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 2*time.Second, "expected the request to time out")
+}
+
 type GetCatalogEntityOpenApiResponse struct {
 	Openapi string                   `json:"openapi" yaml:"openapi"`
 	Info    cortex.CatalogEntityData `json:"info" yaml:"info"`
@@ -120,13 +169,13 @@ func TestClientNoQueryStructDuplication(t *testing.T) {
 	params := cortex.CatalogEntityGetDescriptorParams{
 		Yaml: true,
 	}
-	req, err := c.YamlClient().Get(route).QueryStruct(params).Request()
+	req, err := c.YamlClient(context.Background()).Get(route).QueryStruct(params).Request()
 	assert.Nil(t, err, "error building sling request: %s", err)
 
 	desiredUrl := "http://" + req.Host + desiredUri
 	assert.Equal(t, desiredUrl, req.URL.String(), "expected request URL to be %s, got %s", desiredUrl, req.URL.String())
 
-	req, err = c.YamlClient().Get(route).QueryStruct(params).Request()
+	req, err = c.YamlClient(context.Background()).Get(route).QueryStruct(params).Request()
 	assert.Nil(t, err, "error building sling request: %s", err)
 
 	desiredUrl = "http://" + req.Host + desiredUri
@@ -8,19 +8,21 @@ package cortex
 // match the structure of the CatalogEntity struct in other responses.
 // See: https://github.com/cortexapps/solutions/blob/master/examples/yaml/catalog/resource.yaml
 type CatalogEntityData struct {
-	Title          string                    `json:"title" yaml:"title"`
-	Description    string                    `json:"description,omitempty" yaml:"description,omitempty"`
-	Tag            string                    `json:"x-cortex-tag" yaml:"x-cortex-tag"`
-	Type           string                    `json:"x-cortex-type,omitempty" yaml:"x-cortex-type,omitempty"`
-	Definition     map[string]interface{}    `json:"x-cortex-definition,omitempty" yaml:"x-cortex-definition,omitempty"`
-	Owners         []CatalogEntityOwner      `json:"x-cortex-owners,omitempty" yaml:"x-cortex-owners,omitempty"`
-	Children       []CatalogEntityChild      `json:"x-cortex-children,omitempty" yaml:"x-cortex-children,omitempty"`
-	Parents        []CatalogEntityParent     `json:"x-cortex-parents,omitempty" yaml:"x-cortex-parents,omitempty"`
-	Groups         []string                  `json:"x-cortex-groups,omitempty" yaml:"x-cortex-groups,omitempty"` // TODO: is this -groups or -service-groups? docs unclear
-	Links          []CatalogEntityLink       `json:"x-cortex-link,omitempty" yaml:"x-cortex-link,omitempty"`
-	IgnoreMetadata bool                      `json:"-" yaml:"-"`
-	Metadata       map[string]interface{}    `json:"x-cortex-custom-metadata,omitempty" yaml:"x-cortex-custom-metadata,omitempty"`
-	Dependencies   []CatalogEntityDependency `json:"x-cortex-dependency,omitempty" yaml:"x-cortex-dependency,omitempty"`
+	Title          string                      `json:"title" yaml:"title"`
+	Description    string                      `json:"description,omitempty" yaml:"description,omitempty"`
+	Tag            string                      `json:"x-cortex-tag" yaml:"x-cortex-tag"`
+	Type           string                      `json:"x-cortex-type,omitempty" yaml:"x-cortex-type,omitempty"`
+	Definition     map[string]interface{}      `json:"x-cortex-definition,omitempty" yaml:"x-cortex-definition,omitempty"`
+	Owners         []CatalogEntityOwner        `json:"x-cortex-owners,omitempty" yaml:"x-cortex-owners,omitempty"`
+	Children       []CatalogEntityChild        `json:"x-cortex-children,omitempty" yaml:"x-cortex-children,omitempty"`
+	Parents        []CatalogEntityParent       `json:"x-cortex-parents,omitempty" yaml:"x-cortex-parents,omitempty"`
+	Relationships  []CatalogEntityRelationship `json:"x-cortex-relationships,omitempty" yaml:"x-cortex-relationships,omitempty"`
+	Groups         []string                    `json:"x-cortex-groups,omitempty" yaml:"x-cortex-groups,omitempty"` // TODO: is this -groups or -service-groups? docs unclear
+	Links          []CatalogEntityLink         `json:"x-cortex-link,omitempty" yaml:"x-cortex-link,omitempty"`
+	IgnoreMetadata bool                        `json:"-" yaml:"-"`
+	Metadata       map[string]interface{}      `json:"x-cortex-custom-metadata,omitempty" yaml:"x-cortex-custom-metadata,omitempty"`
+	Dependencies   []CatalogEntityDependency   `json:"x-cortex-dependency,omitempty" yaml:"x-cortex-dependency,omitempty"`
+	ApiSpec        map[string]interface{}      `json:"-" yaml:"-"` // See ApiSpecFromDescriptor
 
 	// Various generic integration attributes
 	Alerts         []CatalogEntityAlert        `json:"x-cortex-alerts,omitempty" yaml:"x-cortex-alerts,omitempty"`
@@ -49,7 +51,9 @@ type CatalogEntityData struct {
 	Wiz            CatalogEntityWiz             `json:"x-cortex-wiz,omitempty" yaml:"x-cortex-wiz,omitempty"`
 
 	// Infrastructure, Resources, and Deployments attributes
-	K8s CatalogEntityK8s `json:"x-cortex-k8s,omitempty" yaml:"x-cortex-k8s,omitempty"`
+	Infra CatalogEntityInfra `json:"x-cortex-infra,omitempty" yaml:"x-cortex-infra,omitempty"`
+	Azure CatalogEntityAzure `json:"x-cortex-azure,omitempty" yaml:"x-cortex-azure,omitempty"`
+	K8s   CatalogEntityK8s   `json:"x-cortex-k8s,omitempty" yaml:"x-cortex-k8s,omitempty"`
 
 	// Team-specific attributes
 	Team CatalogEntityTeam `json:"team" yaml:"x-cortex-team,omitempty"`
@@ -189,6 +193,18 @@ type CatalogEntityParent struct {
 	Tag string `json:"tag" yaml:"tag"`
 }
 
+// CatalogEntityRelationship relates an entity to other entities through a relationship type, such as runs-on. An
+// entity lists its destinations when the relationship type is defined on its sources, and its sources otherwise.
+type CatalogEntityRelationship struct {
+	Type         string                            `json:"type" yaml:"type"`
+	Destinations []CatalogEntityRelationshipEntity `json:"destinations,omitempty" yaml:"destinations,omitempty"`
+	Sources      []CatalogEntityRelationshipEntity `json:"sources,omitempty" yaml:"sources,omitempty"`
+}
+
+type CatalogEntityRelationshipEntity struct {
+	Tag string `json:"tag" yaml:"tag"`
+}
+
 type CatalogEntitySLOs struct {
 	DataDog    []CatalogEntitySLODataDog         `json:"datadog,omitempty" yaml:"datadog,omitempty"`
 	Dynatrace  []CatalogEntitySLODynatrace       `json:"dynatrace,omitempty" yaml:"dynatrace,omitempty"`
@@ -470,6 +486,71 @@ func (o *CatalogEntityIssuesJira) Enabled() bool {
 	return len(o.Projects) > 0 || len(o.Labels) > 0 || len(o.Components) > 0 || o.DefaultJQL != ""
 }
 
+/***********************************************************************************************************************
+ * Infrastructure - https://docs.cortex.io/docs/reference/integrations/aws
+ **********************************************************************************************************************/
+
+type CatalogEntityInfra struct {
+	Aws CatalogEntityInfraAws `json:"aws,omitempty" yaml:"aws,omitempty"`
+	Gcp CatalogEntityInfraGcp `json:"Google Cloud,omitempty" yaml:"Google Cloud,omitempty"`
+}
+
+func (o *CatalogEntityInfra) Enabled() bool {
+	return o.Aws.Enabled() || o.Gcp.Enabled()
+}
+
+type CatalogEntityInfraAws struct {
+	CloudControl []CatalogEntityInfraAwsCloudControl `json:"cloudControl,omitempty" yaml:"cloudControl,omitempty"`
+	Ecs          []CatalogEntityInfraAwsEcs          `json:"ecs,omitempty" yaml:"ecs,omitempty"`
+}
+
+func (o *CatalogEntityInfraAws) Enabled() bool {
+	return len(o.CloudControl) > 0 || len(o.Ecs) > 0
+}
+
+type CatalogEntityInfraAwsCloudControl struct {
+	Type       string `json:"type" yaml:"type"`
+	Region     string `json:"region" yaml:"region"`
+	AccountID  string `json:"accountId" yaml:"accountId"`
+	Identifier string `json:"identifier" yaml:"identifier"`
+}
+
+type CatalogEntityInfraAwsEcs struct {
+	ClusterArn string `json:"clusterArn" yaml:"clusterArn"`
+	ServiceArn string `json:"serviceArn" yaml:"serviceArn"`
+}
+
+type CatalogEntityInfraGcp struct {
+	Resources []CatalogEntityInfraGcpResource `json:"resources,omitempty" yaml:"resources,omitempty"`
+}
+
+func (o *CatalogEntityInfraGcp) Enabled() bool {
+	return len(o.Resources) > 0
+}
+
+type CatalogEntityInfraGcpResource struct {
+	ResourceName string `json:"resourceName" yaml:"resourceName"`
+	ProjectID    string `json:"projectId" yaml:"projectId"`
+	ResourceType string `json:"resourceType" yaml:"resourceType"`
+}
+
+/***********************************************************************************************************************
+ * Azure Resources - https://docs.cortex.io/docs/reference/integrations/azureresources
+ **********************************************************************************************************************/
+
+type CatalogEntityAzure struct {
+	Resources []CatalogEntityAzureResource `json:"ids,omitempty" yaml:"ids,omitempty"`
+}
+
+func (o *CatalogEntityAzure) Enabled() bool {
+	return len(o.Resources) > 0
+}
+
+type CatalogEntityAzureResource struct {
+	ID    string `json:"id" yaml:"id"`
+	Alias string `json:"alias,omitempty" yaml:"alias,omitempty"`
+}
+
 /***********************************************************************************************************************
  * Kubernetes - https://docs.cortex.io/docs/reference/integrations/kubernetes
  **********************************************************************************************************************/
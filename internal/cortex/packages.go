@@ -0,0 +1,162 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dghubble/sling"
+	"slices"
+	"strings"
+)
+
+type PackagesClientInterface interface {
+	List(ctx context.Context, entityTag string) ([]Package, error)
+	Upsert(ctx context.Context, entityTag string, req UpsertPackageRequest) (Package, error)
+	Delete(ctx context.Context, entityTag string, packageType string, name string) error
+}
+
+type PackagesClient struct {
+	client *HttpClient
+}
+
+var _ PackagesClientInterface = &PackagesClient{}
+
+func (c *PackagesClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	PackageTypeGo     = "GO"
+	PackageTypeJava   = "JAVA"
+	PackageTypeNode   = "NODE"
+	PackageTypeNuGet  = "NUGET"
+	PackageTypePython = "PYTHON"
+)
+
+// PackageTypes are the types of the packages that Cortex tracks, in the order they are documented.
+var PackageTypes = []string{PackageTypeGo, PackageTypeJava, PackageTypeNode, PackageTypeNuGet, PackageTypePython}
+
+// SinglePackageTypes are the types of the packages that can be upserted one at a time. Packages of the other types are
+// only set by uploading a whole manifest, such as a package.json or a go.sum.
+var SinglePackageTypes = []string{PackageTypeJava}
+
+// packageTypePaths are the paths of the endpoints for each type of package, under /api/v1/catalog/:tag/packages.
+var packageTypePaths = map[string]string{
+	PackageTypeGo:     "go",
+	PackageTypeJava:   "java",
+	PackageTypeNode:   "node",
+	PackageTypeNuGet:  "dotnet/nuget",
+	PackageTypePython: "python",
+}
+
+// Package is a language package that a catalog entity depends on, such as an npm package or a Go module.
+type Package struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	PackageType string `json:"packageType"`
+	DateCreated string `json:"dateCreated,omitempty"`
+}
+
+// packageTypePath returns the path of the endpoints for a type of package, which is matched case-insensitively.
+func packageTypePath(packageType string) (string, error) {
+	path, ok := packageTypePaths[strings.ToUpper(packageType)]
+	if !ok {
+		return "", fmt.Errorf("unknown package type %q, expected one of %s", packageType, strings.Join(PackageTypes, ", "))
+	}
+	return path, nil
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/catalog/:tag/packages
+ **********************************************************************************************************************/
+
+// List retrieves every package of a catalog entity, whether it was set through the API or found by Cortex.
+func (c *PackagesClient) List(ctx context.Context, entityTag string) ([]Package, error) {
+	var packages []Package
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Get(Route("catalog_entities", entityTag+"/packages")).Receive(&packages, &apiError)
+	if err != nil {
+		return nil, errors.New("could not get catalog entity packages: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return nil, err
+	}
+
+	return packages, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/catalog/:tag/packages/java/single
+ **********************************************************************************************************************/
+
+type UpsertPackageRequest struct {
+	PackageType string `json:"-"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+}
+
+// Upsert creates a package of a catalog entity, or sets the version of the package with the same type and name. Only
+// the SinglePackageTypes can be upserted.
+func (c *PackagesClient) Upsert(ctx context.Context, entityTag string, req UpsertPackageRequest) (Package, error) {
+	pkg := Package{}
+	apiError := ApiError{}
+
+	path, err := packageTypePath(req.PackageType)
+	if err != nil {
+		return pkg, err
+	}
+	if !slices.Contains(SinglePackageTypes, strings.ToUpper(req.PackageType)) {
+		return pkg, fmt.Errorf("packages of type %q cannot be upserted one at a time, expected one of %s", req.PackageType, strings.Join(SinglePackageTypes, ", "))
+	}
+
+	response, err := c.Client(ctx).Post(Route("catalog_entities", entityTag+"/packages/"+path+"/single")).BodyJSON(&req).Receive(&pkg, &apiError)
+	if err != nil {
+		return pkg, fmt.Errorf("failed upserting package for entity: %+v", err)
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return pkg, err
+	}
+
+	return pkg, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/catalog/:tag/packages/:type?name=
+ **********************************************************************************************************************/
+
+type DeletePackageRequest struct {
+	Name string `url:"name"`
+}
+
+// Delete removes the package of a catalog entity with a type and name.
+func (c *PackagesClient) Delete(ctx context.Context, entityTag string, packageType string, name string) error {
+	apiError := ApiError{}
+
+	path, err := packageTypePath(packageType)
+	if err != nil {
+		return err
+	}
+
+	params := DeletePackageRequest{Name: name}
+	response, err := c.Client(ctx).Delete(Route("catalog_entities", entityTag+"/packages/"+path)).QueryStruct(&params).Receive(nil, &apiError)
+	if err != nil {
+		return errors.New("could not delete package for catalog entity: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
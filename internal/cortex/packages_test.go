@@ -0,0 +1,84 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testPackage = cortex.Package{
+	ID:          1,
+	Name:        "com.example:billing-client",
+	Version:     "1.2.3",
+	PackageType: cortex.PackageTypeJava,
+}
+
+func TestListPackages(t *testing.T) {
+	tag := "test-catalog-entity"
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", tag+"/packages"),
+		[]cortex.Package{testPackage},
+		AssertRequestMethod(t, "GET"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Packages().List(context.Background(), tag)
+	assert.Nil(t, err, "error listing packages")
+	assert.Equal(t, []cortex.Package{testPackage}, res)
+}
+
+func TestUpsertPackage(t *testing.T) {
+	tag := "test-catalog-entity"
+	req := cortex.UpsertPackageRequest{
+		PackageType: "java",
+		Name:        testPackage.Name,
+		Version:     testPackage.Version,
+	}
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", tag+"/packages/java/single"),
+		testPackage,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Packages().Upsert(context.Background(), tag, req)
+	assert.Nil(t, err, "error upserting package")
+	assert.Equal(t, testPackage, res)
+}
+
+func TestUpsertPackageRequiresKnownType(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("catalog_entities", "test-catalog-entity/packages/rust/single"), cortex.Package{})
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.Packages().Upsert(context.Background(), "test-catalog-entity", cortex.UpsertPackageRequest{PackageType: "RUST", Name: "serde", Version: "1.0.0"})
+	assert.ErrorContains(t, err, "unknown package type")
+}
+
+func TestUpsertPackageRequiresSinglePackageType(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("catalog_entities", "test-catalog-entity/packages/node/single"), cortex.Package{})
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.Packages().Upsert(context.Background(), "test-catalog-entity", cortex.UpsertPackageRequest{PackageType: cortex.PackageTypeNode, Name: "left-pad", Version: "1.3.0"})
+	assert.ErrorContains(t, err, "cannot be upserted one at a time")
+}
+
+func TestDeletePackage(t *testing.T) {
+	tag := "test-catalog-entity"
+	c, teardown, err := setupClient(
+		cortex.Route("catalog_entities", tag+"/packages/dotnet/nuget"),
+		nil,
+		AssertRequestMethod(t, "DELETE"),
+		AssertRequestURI(t, cortex.Route("catalog_entities", tag+"/packages/dotnet/nuget")+"?name=Internal.Logging"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.Packages().Delete(context.Background(), tag, cortex.PackageTypeNuGet, "Internal.Logging")
+	assert.Nil(t, err, "error deleting package")
+}
@@ -0,0 +1,80 @@
+package cortex
+
+import (
+	"context"
+	"iter"
+)
+
+// listPage is one page of results from a paginated list endpoint.
+type listPage[T any] struct {
+	Items      []T
+	Total      int
+	TotalPages int
+}
+
+// fetchListPage retrieves the given zero-based page of a list endpoint.
+type fetchListPage[T any] func(ctx context.Context, page int) (listPage[T], error)
+
+// paginate returns an iterator over the pages of a list endpoint from firstPage until the last page it reports. An
+// endpoint that reports no totalPages, or returns an empty page, is treated as having no further pages. Iteration stops
+// at the first error, which is yielded with an empty page.
+func paginate[T any](ctx context.Context, firstPage int, fetch fetchListPage[T]) iter.Seq2[listPage[T], error] {
+	return func(yield func(listPage[T], error) bool) {
+		for page := firstPage; ; page++ {
+			p, err := fetch(ctx, page)
+			if err != nil {
+				yield(listPage[T]{}, err)
+				return
+			}
+			if !yield(p, nil) || len(p.Items) == 0 || page+1 >= p.TotalPages {
+				return
+			}
+		}
+	}
+}
+
+// paginatedItems returns an iterator over the items on each page yielded by pages.
+func paginatedItems[T any](pages iter.Seq2[listPage[T], error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p, err := range pages {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range p.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// collectPages returns every item on each page yielded by pages, along with the totals reported by the last page.
+func collectPages[T any](pages iter.Seq2[listPage[T], error]) (listPage[T], error) {
+	result := listPage[T]{Items: []T{}}
+	for p, err := range pages {
+		if err != nil {
+			return listPage[T]{}, err
+		}
+		result.Items = append(result.Items, p.Items...)
+		result.Total = p.Total
+		result.TotalPages = p.TotalPages
+	}
+	return result, nil
+}
+
+// ForEach calls fn with each item yielded by seq, such as the iterators returned by the Iterate methods of the list
+// clients, and stops at the first error from either.
+func ForEach[T any](seq iter.Seq2[T, error], fn func(T) error) error {
+	for item, err := range seq {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cortex_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// setupPagedClient serves pages[n] for requests to requestPath with page=n, and records the page of every request it
+// receives. Requests for a page that does not exist fail with a 400.
+func setupPagedClient(requestPath string, pages []interface{}) (*cortex.HttpClient, *[]int, func(), error) {
+	requested := &[]int{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(requestPath, func(w http.ResponseWriter, req *http.Request) {
+		defer req.Body.Close()
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		*requested = append(*requested, page)
+		if page >= len(pages) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"type":"BAD_REQUEST","message":"no such page"}`))
+			return
+		}
+		if err := json.NewEncoder(w).Encode(pages[page]); err != nil {
+			panic(fmt.Errorf("could not encode JSON: %w", err))
+		}
+	})
+	c, teardown, err := buildClient(mux)
+	return c, requested, teardown, err
+}
+
+func testCatalogEntityPages() []interface{} {
+	return []interface{}{
+		cortex.CatalogEntitiesResponse{Entities: []cortex.CatalogEntity{{Tag: "alpha"}, {Tag: "bravo"}}, Page: 0, Total: 5, TotalPages: 3},
+		cortex.CatalogEntitiesResponse{Entities: []cortex.CatalogEntity{{Tag: "charlie"}, {Tag: "delta"}}, Page: 1, Total: 5, TotalPages: 3},
+		cortex.CatalogEntitiesResponse{Entities: []cortex.CatalogEntity{{Tag: "echo"}}, Page: 2, Total: 5, TotalPages: 3},
+	}
+}
+
+func TestListCatalogEntitiesStitchesPages(t *testing.T) {
+	c, requested, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), testCatalogEntityPages())
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntities().List(context.Background(), &cortex.CatalogEntityListParams{PageSize: 2})
+	assert.Nil(t, err, "error retrieving entities")
+	tags := make([]string, len(res.Entities))
+	for i, entity := range res.Entities {
+		tags[i] = entity.Tag
+	}
+	assert.Equal(t, []string{"alpha", "bravo", "charlie", "delta", "echo"}, tags)
+	assert.Equal(t, 5, res.Total)
+	assert.Equal(t, 3, res.TotalPages)
+	assert.Equal(t, []int{0, 1, 2}, *requested)
+}
+
+func TestListCatalogEntitiesStartsAtPage(t *testing.T) {
+	c, requested, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), testCatalogEntityPages())
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntities().List(context.Background(), &cortex.CatalogEntityListParams{Page: 1})
+	assert.Nil(t, err, "error retrieving entities")
+	assert.Len(t, res.Entities, 3)
+	assert.Equal(t, 1, res.Page)
+	assert.Equal(t, []int{1, 2}, *requested)
+}
+
+func TestIterateCatalogEntitiesFetchesPagesLazily(t *testing.T) {
+	c, requested, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), testCatalogEntityPages())
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	var tags []string
+	for entity, err := range c.CatalogEntities().Iterate(context.Background(), nil) {
+		assert.Nil(t, err, "error iterating entities")
+		tags = append(tags, entity.Tag)
+		if entity.Tag == "charlie" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"alpha", "bravo", "charlie"}, tags)
+	assert.Equal(t, []int{0, 1}, *requested, "expected the last page not to be fetched")
+}
+
+func TestListCatalogEntitiesFailsOnPageError(t *testing.T) {
+	// The first page claims there are more pages than the server has, so fetching the second one fails.
+	pages := []interface{}{
+		cortex.CatalogEntitiesResponse{Entities: []cortex.CatalogEntity{{Tag: "alpha"}}, Total: 2, TotalPages: 2},
+	}
+	c, _, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), pages)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	_, err = c.CatalogEntities().List(context.Background(), nil)
+	assert.NotNil(t, err, "expected an error from the second page")
+}
+
+func TestListStopsWithoutTotalPages(t *testing.T) {
+	pages := []interface{}{
+		cortex.CatalogEntitiesResponse{Entities: []cortex.CatalogEntity{{Tag: "alpha"}}},
+	}
+	c, requested, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), pages)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.CatalogEntities().List(context.Background(), nil)
+	assert.Nil(t, err, "error retrieving entities")
+	assert.Len(t, res.Entities, 1)
+	assert.Equal(t, []int{0}, *requested)
+}
+
+func TestListTeamsStitchesPages(t *testing.T) {
+	pages := []interface{}{
+		cortex.TeamsResponse{Teams: []cortex.Team{{TeamTag: "alpha"}}, Page: 0, Total: 2, TotalPages: 2},
+		cortex.TeamsResponse{Teams: []cortex.Team{{TeamTag: "bravo"}}, Page: 1, Total: 2, TotalPages: 2},
+	}
+	c, requested, teardown, err := setupPagedClient(cortex.Route("teams", ""), pages)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Teams().List(context.Background(), &cortex.TeamListParams{})
+	assert.Nil(t, err, "error retrieving teams")
+	assert.Len(t, res.Teams, 2)
+	assert.Equal(t, "bravo", res.Teams[1].TeamTag)
+	assert.Equal(t, []int{0, 1}, *requested)
+}
+
+func TestListResourceDefinitionsStitchesPages(t *testing.T) {
+	pages := []interface{}{
+		cortex.ResourceDefinitionsResponse{ResourceDefinitions: []cortex.ResourceDefinition{{Type: "alpha"}}, Page: 0, Total: 2, TotalPages: 2},
+		cortex.ResourceDefinitionsResponse{ResourceDefinitions: []cortex.ResourceDefinition{{Type: "bravo"}}, Page: 1, Total: 2, TotalPages: 2},
+	}
+	c, requested, teardown, err := setupPagedClient(cortex.Route("resource_definitions", ""), pages)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.ResourceDefinitions().List(context.Background(), &cortex.ResourceDefinitionListParams{})
+	assert.Nil(t, err, "error retrieving resource definitions")
+	assert.Len(t, res.ResourceDefinitions, 2)
+	assert.Equal(t, "bravo", res.ResourceDefinitions[1].Type)
+	assert.Equal(t, []int{0, 1}, *requested)
+}
+
+func TestForEachStopsAtCallbackError(t *testing.T) {
+	c, requested, teardown, err := setupPagedClient(cortex.Route("catalog_entities", ""), testCatalogEntityPages())
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	stop := errors.New("stop")
+	var tags []string
+	err = cortex.ForEach(c.CatalogEntities().Iterate(context.Background(), nil), func(entity cortex.CatalogEntity) error {
+		tags = append(tags, entity.Tag)
+		if len(tags) == 2 {
+			return stop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, stop)
+	assert.Equal(t, []string{"alpha", "bravo"}, tags)
+	assert.Equal(t, []int{0}, *requested)
+}
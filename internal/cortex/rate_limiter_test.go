@@ -0,0 +1,44 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimitSharedAcrossSubClients(t *testing.T) {
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}, cortex.WithRequestsPerSecond(50))
+
+	// The bucket starts with a burst of 50 tokens, so 20 more requests need at least 400ms of refill.
+	start := time.Now()
+	for i := 0; i < 70; i++ {
+		if i%2 == 0 {
+			_, _ = c.Teams().Get(context.Background(), "test-team")
+		} else {
+			_, _ = c.Departments().Get(context.Background(), "test-department")
+		}
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 350*time.Millisecond)
+}
+
+func TestRateLimitHonorsContextCancellation(t *testing.T) {
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}, cortex.WithRequestsPerSecond(0.5))
+
+	err := c.Ping(context.Background())
+	assert.Nil(t, err, "expected the first request to use the initial burst")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = c.Ping(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second, "expected the rate-limited request to be abandoned")
+}
@@ -0,0 +1,208 @@
+package cortex
+
+import (
+	"context"
+	"errors"
+	"github.com/dghubble/sling"
+)
+
+type RelationshipTypesClientInterface interface {
+	Get(ctx context.Context, tag string) (RelationshipType, error)
+	Create(ctx context.Context, req CreateRelationshipTypeRequest) (RelationshipType, error)
+	Update(ctx context.Context, tag string, req UpdateRelationshipTypeRequest) (RelationshipType, error)
+	Delete(ctx context.Context, tag string) error
+}
+
+type RelationshipTypesClient struct {
+	client *HttpClient
+}
+
+var _ RelationshipTypesClientInterface = &RelationshipTypesClient{}
+
+func (c *RelationshipTypesClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+const (
+	// RelationshipTypeDefinitionLocationSource is for relationships listed in the descriptors of their sources.
+	RelationshipTypeDefinitionLocationSource = "SOURCE"
+	// RelationshipTypeDefinitionLocationDestination is for relationships listed in the descriptors of their destinations.
+	RelationshipTypeDefinitionLocationDestination = "DESTINATION"
+	// RelationshipTypeDefinitionLocationBoth is for relationships listed in the descriptors of either end.
+	RelationshipTypeDefinitionLocationBoth = "BOTH"
+)
+
+// RelationshipTypeDefinitionLocations are the entities whose descriptors can list a relationship.
+var RelationshipTypeDefinitionLocations = []string{
+	RelationshipTypeDefinitionLocationSource,
+	RelationshipTypeDefinitionLocationDestination,
+	RelationshipTypeDefinitionLocationBoth,
+}
+
+const (
+	// RelationshipTypeFilterAll allows entities of every type.
+	RelationshipTypeFilterAll = "ALL"
+	// RelationshipTypeFilterInclude only allows entities of the types in the filter.
+	RelationshipTypeFilterInclude = "INCLUDE"
+)
+
+// RelationshipType is a custom relationship between catalog entities, such as runs-on or deploys-to, that entities list
+// in their x-cortex-relationships.
+type RelationshipType struct {
+	Tag                 string                 `json:"tag"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description,omitempty"`
+	DefinitionLocation  string                 `json:"definitionLocation,omitempty"`
+	AllowCycles         bool                   `json:"allowCycles"`
+	CreateCatalog       bool                   `json:"createCatalog"`
+	IsSingleSource      bool                   `json:"isSingleSource"`
+	IsSingleDestination bool                   `json:"isSingleDestination"`
+	SourcesFilter       RelationshipTypeFilter `json:"sourcesFilter"`
+	DestinationsFilter  RelationshipTypeFilter `json:"destinationsFilter"`
+}
+
+// RelationshipTypeFilter restricts the types of the entities at one end of a relationship.
+type RelationshipTypeFilter struct {
+	Type    string   `json:"type"`
+	Include []string `json:"include,omitempty"`
+}
+
+// NewRelationshipTypeFilter returns a filter that only allows the given entity types, or every type when there are none.
+func NewRelationshipTypeFilter(entityTypes []string) RelationshipTypeFilter {
+	if len(entityTypes) == 0 {
+		return RelationshipTypeFilter{Type: RelationshipTypeFilterAll}
+	}
+	return RelationshipTypeFilter{Type: RelationshipTypeFilterInclude, Include: entityTypes}
+}
+
+// EntityTypes returns the entity types the filter allows, or nil when it allows every type.
+func (f *RelationshipTypeFilter) EntityTypes() []string {
+	if f.Type == RelationshipTypeFilterAll {
+		return nil
+	}
+	return f.Include
+}
+
+/***********************************************************************************************************************
+ * GET /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (c *RelationshipTypesClient) Get(ctx context.Context, tag string) (RelationshipType, error) {
+	data := RelationshipType{}
+	apiError := ApiError{}
+	response, err := c.Client(ctx).Get(Route("relationship_types", tag)).Receive(&data, &apiError)
+	if err != nil {
+		return data, errors.New("could not get relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+/***********************************************************************************************************************
+ * POST /api/v1/relationship-types
+ **********************************************************************************************************************/
+
+type CreateRelationshipTypeRequest struct {
+	Tag string `json:"tag"`
+	UpdateRelationshipTypeRequest
+}
+
+func (r *RelationshipType) ToCreateRequest() CreateRelationshipTypeRequest {
+	return CreateRelationshipTypeRequest{
+		Tag:                           r.Tag,
+		UpdateRelationshipTypeRequest: r.ToUpdateRequest(),
+	}
+}
+
+func (c *RelationshipTypesClient) Create(ctx context.Context, req CreateRelationshipTypeRequest) (RelationshipType, error) {
+	data := RelationshipType{}
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Post(Route("relationship_types", "")).BodyJSON(&req).Receive(&data, &apiError)
+	if err != nil {
+		return data, errors.New("could not create relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+/***********************************************************************************************************************
+ * PUT /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+type UpdateRelationshipTypeRequest struct {
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description,omitempty"`
+	DefinitionLocation  string                 `json:"definitionLocation,omitempty"`
+	AllowCycles         bool                   `json:"allowCycles"`
+	CreateCatalog       bool                   `json:"createCatalog"`
+	IsSingleSource      bool                   `json:"isSingleSource"`
+	IsSingleDestination bool                   `json:"isSingleDestination"`
+	SourcesFilter       RelationshipTypeFilter `json:"sourcesFilter"`
+	DestinationsFilter  RelationshipTypeFilter `json:"destinationsFilter"`
+}
+
+func (r *RelationshipType) ToUpdateRequest() UpdateRelationshipTypeRequest {
+	return UpdateRelationshipTypeRequest{
+		Name:                r.Name,
+		Description:         r.Description,
+		DefinitionLocation:  r.DefinitionLocation,
+		AllowCycles:         r.AllowCycles,
+		CreateCatalog:       r.CreateCatalog,
+		IsSingleSource:      r.IsSingleSource,
+		IsSingleDestination: r.IsSingleDestination,
+		SourcesFilter:       r.SourcesFilter,
+		DestinationsFilter:  r.DestinationsFilter,
+	}
+}
+
+func (c *RelationshipTypesClient) Update(ctx context.Context, tag string, req UpdateRelationshipTypeRequest) (RelationshipType, error) {
+	data := RelationshipType{}
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Put(Route("relationship_types", tag)).BodyJSON(&req).Receive(&data, &apiError)
+	if err != nil {
+		return data, errors.New("could not update relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
+
+/***********************************************************************************************************************
+ * DELETE /api/v1/relationship-types/:tag
+ **********************************************************************************************************************/
+
+func (c *RelationshipTypesClient) Delete(ctx context.Context, tag string) error {
+	apiError := ApiError{}
+
+	response, err := c.Client(ctx).Delete(Route("relationship_types", tag)).Receive(nil, &apiError)
+	if err != nil {
+		return errors.New("could not delete relationship type: " + err.Error())
+	}
+
+	err = c.client.handleResponseStatus(response, &apiError)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testRelationshipType = cortex.RelationshipType{
+	Tag:                 "runs-on",
+	Name:                "Runs on",
+	Description:         "Clusters that a service is deployed to",
+	DefinitionLocation:  cortex.RelationshipTypeDefinitionLocationSource,
+	IsSingleDestination: true,
+	SourcesFilter:       cortex.NewRelationshipTypeFilter([]string{"service"}),
+	DestinationsFilter:  cortex.NewRelationshipTypeFilter(nil),
+}
+
+func TestGetRelationshipType(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("relationship_types", "runs-on"), testRelationshipType, AssertRequestMethod(t, "GET"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Get(context.Background(), "runs-on")
+	assert.Nil(t, err, "error retrieving a relationship type")
+	assert.Equal(t, testRelationshipType, res)
+	assert.Equal(t, []string{"service"}, res.SourcesFilter.EntityTypes())
+	assert.Nil(t, res.DestinationsFilter.EntityTypes())
+}
+
+func TestCreateRelationshipType(t *testing.T) {
+	req := testRelationshipType.ToCreateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", ""),
+		testRelationshipType,
+		AssertRequestMethod(t, "POST"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Create(context.Background(), req)
+	assert.Nil(t, err, "error creating a relationship type")
+	assert.Equal(t, testRelationshipType, res)
+}
+
+func TestUpdateRelationshipType(t *testing.T) {
+	req := testRelationshipType.ToUpdateRequest()
+	c, teardown, err := setupClient(
+		cortex.Route("relationship_types", "runs-on"),
+		testRelationshipType,
+		AssertRequestMethod(t, "PUT"),
+		AssertRequestBody(t, req),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.RelationshipTypes().Update(context.Background(), "runs-on", req)
+	assert.Nil(t, err, "error updating a relationship type")
+	assert.Equal(t, testRelationshipType, res)
+}
+
+func TestDeleteRelationshipType(t *testing.T) {
+	c, teardown, err := setupClient(cortex.Route("relationship_types", "runs-on"), nil, AssertRequestMethod(t, "DELETE"))
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	err = c.RelationshipTypes().Delete(context.Background(), "runs-on")
+	assert.Nil(t, err, "error deleting a relationship type")
+}
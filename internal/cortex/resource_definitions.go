@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"github.com/dghubble/sling"
+	"iter"
 )
 
 type ResourceDefinitionsClientInterface interface {
 	Get(ctx context.Context, typeName string) (ResourceDefinition, error)
 	List(ctx context.Context, params *ResourceDefinitionListParams) (ResourceDefinitionsResponse, error)
+	ListPage(ctx context.Context, params *ResourceDefinitionListParams) (ResourceDefinitionsResponse, error)
+	Iterate(ctx context.Context, params *ResourceDefinitionListParams) iter.Seq2[ResourceDefinition, error]
 	Create(ctx context.Context, req CreateResourceDefinitionRequest) (ResourceDefinition, error)
 	Update(ctx context.Context, typeName string, req UpdateResourceDefinitionRequest) (ResourceDefinition, error)
 	Delete(ctx context.Context, typeName string) error
@@ -20,8 +23,8 @@ type ResourceDefinitionsClient struct {
 
 var _ ResourceDefinitionsClientInterface = &ResourceDefinitionsClient{}
 
-func (c *ResourceDefinitionsClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *ResourceDefinitionsClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
 /***********************************************************************************************************************
@@ -56,7 +59,7 @@ func (r *ResourceDefinition) SchemaAsString() (string, error) {
 func (c *ResourceDefinitionsClient) Get(ctx context.Context, typeName string) (ResourceDefinition, error) {
 	data := ResourceDefinition{}
 	apiError := ApiError{}
-	response, err := c.Client().Get(Route("resource_definitions", typeName)).Receive(&data, &apiError)
+	response, err := c.Client(ctx).Get(Route("resource_definitions", typeName)).Receive(&data, &apiError)
 	if err != nil {
 		return data, errors.New("could not get resource definition: " + err.Error())
 	}
@@ -76,19 +79,63 @@ func (c *ResourceDefinitionsClient) Get(ctx context.Context, typeName string) (R
 // ResourceDefinitionListParams are the query parameters for the GET /v1/catalog/definitions endpoint.
 type ResourceDefinitionListParams struct {
 	IncludeBuiltIn bool `url:"includeBuiltIn,omitempty"`
+	Page           int  `url:"page,omitempty"`
+	PageSize       int  `url:"pageSize,omitempty"`
 }
 
 // ResourceDefinitionsResponse is the response from the GET /v1/catalog/definitions endpoint.
 type ResourceDefinitionsResponse struct {
 	ResourceDefinitions []ResourceDefinition `json:"definitions"`
+	Page                int                  `json:"page"`
+	Total               int                  `json:"total"`
+	TotalPages          int                  `json:"totalPages"`
 }
 
-// List retrieves a list of resource definitions based on a query.
+// List retrieves every resource definition matching a query, fetching each page from params.Page onwards.
 func (c *ResourceDefinitionsClient) List(ctx context.Context, params *ResourceDefinitionListParams) (ResourceDefinitionsResponse, error) {
+	p := ResourceDefinitionListParams{}
+	if params != nil {
+		p = *params
+	}
+	result, err := collectPages(c.pages(ctx, p))
+	if err != nil {
+		return ResourceDefinitionsResponse{}, err
+	}
+	return ResourceDefinitionsResponse{
+		ResourceDefinitions: result.Items,
+		Page:                p.Page,
+		Total:               result.Total,
+		TotalPages:          result.TotalPages,
+	}, nil
+}
+
+// Iterate returns an iterator over every resource definition matching a query, fetching each page from params.Page
+// onwards only once the definitions on the previous page have been consumed.
+func (c *ResourceDefinitionsClient) Iterate(ctx context.Context, params *ResourceDefinitionListParams) iter.Seq2[ResourceDefinition, error] {
+	p := ResourceDefinitionListParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginatedItems(c.pages(ctx, p))
+}
+
+func (c *ResourceDefinitionsClient) pages(ctx context.Context, params ResourceDefinitionListParams) iter.Seq2[listPage[ResourceDefinition], error] {
+	return paginate(ctx, params.Page, func(ctx context.Context, page int) (listPage[ResourceDefinition], error) {
+		params.Page = page
+		resp, err := c.ListPage(ctx, &params)
+		if err != nil {
+			return listPage[ResourceDefinition]{}, err
+		}
+		return listPage[ResourceDefinition]{Items: resp.ResourceDefinitions, Total: resp.Total, TotalPages: resp.TotalPages}, nil
+	})
+}
+
+// ListPage retrieves a single page of resource definitions matching a query.
+func (c *ResourceDefinitionsClient) ListPage(ctx context.Context, params *ResourceDefinitionListParams) (ResourceDefinitionsResponse, error) {
 	data := ResourceDefinitionsResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Get(Route("resource_definitions", "")).QueryStruct(&params).Receive(&data, &apiError)
+	response, err := c.Client(ctx).Get(Route("resource_definitions", "")).QueryStruct(params).Receive(&data, &apiError)
 	if err != nil {
 		return data, errors.New("could not get resource definitions: " + err.Error())
 	}
@@ -130,7 +177,7 @@ func (c *ResourceDefinitionsClient) Create(ctx context.Context, req CreateResour
 	data := ResourceDefinition{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Post(Route("resource_definitions", "")).BodyJSON(&req).Receive(&data, &apiError)
+	response, err := c.Client(ctx).Post(Route("resource_definitions", "")).BodyJSON(&req).Receive(&data, &apiError)
 	if err != nil {
 		return data, errors.New("could not create a resource definition: " + err.Error())
 	}
@@ -165,7 +212,7 @@ func (c *ResourceDefinitionsClient) Update(ctx context.Context, typeName string,
 	data := ResourceDefinition{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Put(Route("resource_definitions", typeName)).BodyJSON(&req).Receive(&data, &apiError)
+	response, err := c.Client(ctx).Put(Route("resource_definitions", typeName)).BodyJSON(&req).Receive(&data, &apiError)
 	if err != nil {
 		return data, errors.New("could not update a resource definition: " + err.Error())
 	}
@@ -188,7 +235,7 @@ func (c *ResourceDefinitionsClient) Delete(ctx context.Context, typeName string)
 	deleteDefinitionResponse := DeleteResourceDefinitionResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Delete(Route("resource_definitions", typeName)).Receive(&deleteDefinitionResponse, &apiError)
+	response, err := c.Client(ctx).Delete(Route("resource_definitions", typeName)).Receive(&deleteDefinitionResponse, &apiError)
 	if err != nil {
 		return errors.New("could not delete resource definition: " + err.Error())
 	}
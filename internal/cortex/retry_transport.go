@@ -0,0 +1,136 @@
+package cortex
+
+import (
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed, retryable request is re-attempted before giving up.
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the upper bound on how long we wait between two attempts of the same request.
+	DefaultRetryMaxWait = 30 * time.Second
+	// retryMinWait is the base wait used for the exponential backoff.
+	retryMinWait = 500 * time.Millisecond
+)
+
+// retryTransport is an http.RoundTripper that retries rate-limited (429) and server error (5xx) responses with
+// jittered exponential backoff, honoring the Retry-After header when the API sends one.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries int, maxWait time.Duration) *retryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		maxWait:    maxWait,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.maxRetries <= 0 || !isIdempotentRequest(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		response, err := t.next.RoundTrip(req)
+		if err != nil || !isRetryableStatus(response.StatusCode) || attempt >= t.maxRetries {
+			return response, err
+		}
+		// We can't replay a request whose body we cannot rewind.
+		if req.Body != nil && req.GetBody == nil {
+			return response, nil
+		}
+
+		wait := t.backoff(attempt, response)
+		drainBody(response)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff determines how long to wait before the next attempt. A Retry-After header takes precedence over the
+// computed exponential backoff, but is still capped by maxWait.
+func (t *retryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if wait, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+		return min(wait, t.maxWait)
+	}
+
+	ceiling := float64(retryMinWait) * math.Pow(2, float64(attempt))
+	if ceiling > float64(t.maxWait) {
+		ceiling = float64(t.maxWait)
+	}
+	// Equal jitter: pick a random wait between half the ceiling and the ceiling itself.
+	return time.Duration(ceiling/2 + rand.Float64()*ceiling/2)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= 500 && code != http.StatusNotImplemented)
+}
+
+// isIdempotentRequest reports whether a request can safely be sent more than once. Besides the idempotent HTTP
+// methods, the descriptor and custom data endpoints are upserts, so repeating a POST to them is harmless.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		p := req.URL.Path
+		return p == Route("open_api", "") ||
+			p == Route("scorecards", "descriptor") ||
+			(strings.HasPrefix(p, Route("catalog_entities", "")) && strings.HasSuffix(p, "/custom-data"))
+	}
+	return false
+}
+
+// drainBody reads and closes the response body so the underlying connection can be re-used.
+func drainBody(response *http.Response) {
+	if response.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096))
+	_ = response.Body.Close()
+}
@@ -0,0 +1,101 @@
+package cortex_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func buildRetryClient(t *testing.T, h http.HandlerFunc, opts ...cortex.OptionDelegator) *cortex.HttpClient {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+
+	opts = append([]cortex.OptionDelegator{
+		cortex.WithURL(ts.URL),
+		cortex.WithToken("test"),
+		cortex.WithVersion("test"),
+		cortex.WithRetryMaxWait(10 * time.Millisecond),
+	}, opts...)
+	c, err := cortex.NewClient(opts...)
+	assert.Nil(t, err, "could not build client")
+	return c
+}
+
+func TestRetryOnRateLimit(t *testing.T) {
+	var attempts int32
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"teamTag":"test-team","metadata":{"name":"Test Team"}}`))
+	})
+
+	res, err := c.Teams().Get(context.Background(), "test-team")
+	assert.Nil(t, err, "expected request to succeed after retries")
+	assert.Equal(t, "test-team", res.TeamTag)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, cortex.WithMaxRetries(2))
+
+	_, err := c.Teams().Get(context.Background(), "test-team")
+	assert.NotNil(t, err, "expected request to fail")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryReplaysUpsertBody(t *testing.T) {
+	var attempts int32
+	var bodies []string
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		b, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(b))
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"tag":"test-entity","key":"test-key","value":"test"}`))
+	})
+
+	req := cortex.UpsertCatalogEntityCustomDataRequest{Key: "test-key", Value: "test"}
+	_, err := c.CatalogEntityCustomData().Upsert(context.Background(), "test-entity", req)
+	assert.Nil(t, err, "expected upsert to succeed after a retry")
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1], "expected the retried request to send the same body")
+}
+
+func TestRetrySkipsNonIdempotentRequests(t *testing.T) {
+	var attempts int32
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := c.Teams().Create(context.Background(), cortex.CreateTeamRequest{TeamTag: "test-team"})
+	assert.NotNil(t, err, "expected request to fail")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts), "expected team creation not to be retried")
+}
+
+func TestRetryDisabled(t *testing.T) {
+	var attempts int32
+	c := buildRetryClient(t, func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, cortex.WithMaxRetries(0))
+
+	_, err := c.Teams().Get(context.Background(), "test-team")
+	assert.NotNil(t, err, "expected request to fail")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
@@ -23,12 +23,12 @@ type ScorecardsClient struct {
 
 var _ ScorecardsClientInterface = &ScorecardsClient{}
 
-func (c *ScorecardsClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *ScorecardsClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
-func (c *ScorecardsClient) YamlClient() *sling.Sling {
-	return c.client.YamlClient()
+func (c *ScorecardsClient) YamlClient(ctx context.Context) *sling.Sling {
+	return c.client.YamlClient(ctx)
 }
 
 /***********************************************************************************************************************
@@ -123,7 +123,7 @@ func (c *ScorecardsClient) Get(ctx context.Context, tag string) (Scorecard, erro
 	apiError := ApiError{}
 
 	uri := Route("scorecards", tag+"/descriptor")
-	cl := c.YamlClient().Get(uri)
+	cl := c.YamlClient(ctx).Get(uri)
 	response, err := cl.Receive(scorecardDescriptorResponse, &apiError)
 	if err != nil {
 		return Scorecard{}, errors.Join(fmt.Errorf("failed getting scorecard descriptor for %s from %s", tag, uri), err)
@@ -159,7 +159,7 @@ func (c *ScorecardsClient) Upsert(ctx context.Context, scorecard Scorecard) (Sco
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("CREATE body: %+v", body))
-	response, err := c.Client().
+	response, err := c.Client(ctx).
 		Set("Content-Type", "application/yaml;charset=UTF-8").
 		Set("Accept", "application/json").
 		Post(Route("scorecards", "descriptor")).
@@ -188,7 +188,7 @@ func (c *ScorecardsClient) Delete(ctx context.Context, tag string) error {
 	scorecardResponse := DeleteScorecardResponse{}
 	apiError := ApiError{}
 
-	response, err := c.Client().Delete(Route("scorecards", tag)).Receive(&scorecardResponse, &apiError)
+	response, err := c.Client(ctx).Delete(Route("scorecards", tag)).Receive(&scorecardResponse, &apiError)
 	if err != nil {
 		return errors.New("could not delete scorecard: " + err.Error())
 	}
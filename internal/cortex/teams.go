@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/dghubble/sling"
+	"iter"
 	"log"
 )
 
 type TeamsClientInterface interface {
 	Get(ctx context.Context, tag string) (*Team, error)
 	List(ctx context.Context, params *TeamListParams) (*TeamsResponse, error)
+	ListPage(ctx context.Context, params *TeamListParams) (*TeamsResponse, error)
+	Iterate(ctx context.Context, params *TeamListParams) iter.Seq2[Team, error]
 	Create(ctx context.Context, createReq CreateTeamRequest) (*Team, error)
 	Update(ctx context.Context, tag string, updateReq UpdateTeamRequest) (*Team, error)
 	Delete(ctx context.Context, tag string) error
@@ -25,14 +28,20 @@ type TeamsClient struct {
 
 var _ TeamsClientInterface = &TeamsClient{}
 
-func (c *TeamsClient) Client() *sling.Sling {
-	return c.client.Client()
+func (c *TeamsClient) Client(ctx context.Context) *sling.Sling {
+	return c.client.Client(ctx)
 }
 
 /***********************************************************************************************************************
  * Types
  **********************************************************************************************************************/
 
+// Team types, which decide whether team membership comes from an identity provider group or is managed in Cortex.
+const (
+	TeamTypeCortex = "CORTEX"
+	TeamTypeIdp    = "IDP"
+)
+
 // Team is the response from the GET /v1/teams/{team_tag} endpoint.
 type Team struct {
 	AdditionalMembers []TeamMember       `json:"additionalMembers,omitempty"`
@@ -41,6 +50,9 @@ type Team struct {
 	SlackChannels     []TeamSlackChannel `json:"slackChannels,omitempty"`
 	Links             []TeamLink         `json:"links,omitempty"`
 	TeamTag           string             `json:"teamTag"`
+	Type              string             `json:"type,omitempty"`
+	CortexTeam        *TeamCortexManaged `json:"cortexTeam,omitempty"`
+	IdpGroup          *TeamIdpGroup      `json:"idpGroup,omitempty"`
 }
 
 type TeamMetadata struct {
@@ -69,7 +81,7 @@ type TeamSlackChannel struct {
 
 type TeamIdpGroup struct {
 	Group    string               `json:"group"`
-	Members  []TeamIdpGroupMember `json:"members"`
+	Members  []TeamIdpGroupMember `json:"members,omitempty"`
 	Provider string               `json:"provider"`
 }
 
@@ -90,7 +102,7 @@ type TeamIdpGroupMember struct {
 func (c *TeamsClient) Get(ctx context.Context, tag string) (*Team, error) {
 	teamResponse := &Team{}
 	apiError := &ApiError{}
-	response, err := c.Client().Get(Route("teams", tag)).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Get(Route("teams", tag)).Receive(teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not get team: " + err.Error())
 	}
@@ -109,19 +121,64 @@ func (c *TeamsClient) Get(ctx context.Context, tag string) (*Team, error) {
 
 // TeamListParams are the query parameters for the GET /v1/teams endpoint.
 type TeamListParams struct {
+	IncludeTeamsWithoutMembers bool `url:"includeTeamsWithoutMembers,omitempty"`
+	Page                       int  `url:"page,omitempty"`
+	PageSize                   int  `url:"pageSize,omitempty"`
 }
 
 // TeamsResponse is the response from the GET /v1/teams endpoint.
 type TeamsResponse struct {
-	Teams []Team `json:"teams"`
+	Teams      []Team `json:"teams"`
+	Page       int    `json:"page"`
+	Total      int    `json:"total"`
+	TotalPages int    `json:"totalPages"`
 }
 
-// List retrieves a list of teams based on a team query.
+// List retrieves every team matching a team query, fetching each page from params.Page onwards.
 func (c *TeamsClient) List(ctx context.Context, params *TeamListParams) (*TeamsResponse, error) {
+	p := TeamListParams{}
+	if params != nil {
+		p = *params
+	}
+	result, err := collectPages(c.pages(ctx, p))
+	if err != nil {
+		return nil, err
+	}
+	return &TeamsResponse{
+		Teams:      result.Items,
+		Page:       p.Page,
+		Total:      result.Total,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+// Iterate returns an iterator over every team matching a team query, fetching each page from params.Page onwards only
+// once the teams on the previous page have been consumed.
+func (c *TeamsClient) Iterate(ctx context.Context, params *TeamListParams) iter.Seq2[Team, error] {
+	p := TeamListParams{}
+	if params != nil {
+		p = *params
+	}
+	return paginatedItems(c.pages(ctx, p))
+}
+
+func (c *TeamsClient) pages(ctx context.Context, params TeamListParams) iter.Seq2[listPage[Team], error] {
+	return paginate(ctx, params.Page, func(ctx context.Context, page int) (listPage[Team], error) {
+		params.Page = page
+		resp, err := c.ListPage(ctx, &params)
+		if err != nil {
+			return listPage[Team]{}, err
+		}
+		return listPage[Team]{Items: resp.Teams, Total: resp.Total, TotalPages: resp.TotalPages}, nil
+	})
+}
+
+// ListPage retrieves a single page of teams matching a team query.
+func (c *TeamsClient) ListPage(ctx context.Context, params *TeamListParams) (*TeamsResponse, error) {
 	teamsResponse := &TeamsResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Get(Route("teams", "")).QueryStruct(&params).Receive(teamsResponse, apiError)
+	response, err := c.Client(ctx).Get(Route("teams", "")).QueryStruct(params).Receive(teamsResponse, apiError)
 	if err != nil {
 		return nil, errors.New("could not get teams: " + err.Error())
 	}
@@ -146,15 +203,15 @@ type CreateTeamRequest struct {
 	AdditionalMembers []TeamMember       `json:"additionalMembers"`
 	SlackChannels     []TeamSlackChannel `json:"slackChannels"`
 	Links             []TeamLink         `json:"links"`
-	CortexTeam        TeamCortexManaged  `json:"cortexTeam,omitempty"`
-	IdpGroup          TeamIdpGroup       `json:"idpGroup,omitempty"`
+	CortexTeam        *TeamCortexManaged `json:"cortexTeam,omitempty"`
+	IdpGroup          *TeamIdpGroup      `json:"idpGroup,omitempty"`
 }
 
 func (c *TeamsClient) Create(ctx context.Context, req CreateTeamRequest) (*Team, error) {
 	teamResponse := &Team{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Post(Route("teams", "")).BodyJSON(&req).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Post(Route("teams", "")).BodyJSON(&req).Receive(teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not create team: " + err.Error())
 	}
@@ -178,13 +235,16 @@ type UpdateTeamRequest struct {
 	Links             []TeamLink         `json:"links"`
 	SlackChannels     []TeamSlackChannel `json:"slackChannels"`
 	AdditionalMembers []TeamMember       `json:"additionalMembers"`
+	Type              string             `json:"type,omitempty"`
+	CortexTeam        *TeamCortexManaged `json:"cortexTeam,omitempty"`
+	IdpGroup          *TeamIdpGroup      `json:"idpGroup,omitempty"`
 }
 
 func (c *TeamsClient) Update(ctx context.Context, tag string, req UpdateTeamRequest) (*Team, error) {
 	teamResponse := &Team{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag)).BodyJSON(&req).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Put(Route("teams", tag)).BodyJSON(&req).Receive(teamResponse, apiError)
 	if err != nil {
 		return teamResponse, errors.New("could not update team: " + err.Error())
 	}
@@ -213,7 +273,7 @@ func (c *TeamsClient) Delete(ctx context.Context, tag string) error {
 	apiError := &ApiError{}
 	req := DeleteTeamRequest{Tag: tag}
 
-	response, err := c.Client().Delete(Route("teams", "")).QueryStruct(req).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Delete(Route("teams", "")).QueryStruct(req).Receive(teamResponse, apiError)
 	if err != nil {
 		return fmt.Errorf("could not delete team %v:\n\n%+v", tag, err.Error())
 	}
@@ -237,7 +297,7 @@ func (c *TeamsClient) Archive(ctx context.Context, tag string) error {
 	teamResponse := &ArchiveTeamResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag+"/archive")).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Put(Route("teams", tag+"/archive")).Receive(teamResponse, apiError)
 	if err != nil {
 		return fmt.Errorf("could not archive team: %v", err.Error())
 	}
@@ -260,7 +320,7 @@ func (c *TeamsClient) Unarchive(ctx context.Context, tag string) error {
 	teamResponse := &UnarchiveTeamResponse{}
 	apiError := &ApiError{}
 
-	response, err := c.Client().Put(Route("teams", tag+"/unarchive")).Receive(teamResponse, apiError)
+	response, err := c.Client(ctx).Put(Route("teams", tag+"/unarchive")).Receive(teamResponse, apiError)
 	if err != nil {
 		return errors.New("could not unarchive team: " + err.Error())
 	}
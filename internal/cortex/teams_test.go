@@ -63,6 +63,22 @@ func TestListTeams(t *testing.T) {
 	assert.Equal(t, res.Teams[0].TeamTag, firstTeamTag)
 }
 
+func TestListTeamsIncludingTeamsWithoutMembers(t *testing.T) {
+	resp := &cortex.TeamsResponse{Teams: []cortex.Team{*testTeamResponse}}
+	c, teardown, err := setupClient(
+		cortex.Route("teams", ""),
+		resp,
+		AssertRequestMethod(t, "GET"),
+		AssertRequestURI(t, cortex.Route("teams", "")+"?includeTeamsWithoutMembers=true"),
+	)
+	assert.Nil(t, err, "could not setup client")
+	defer teardown()
+
+	res, err := c.Teams().List(context.Background(), &cortex.TeamListParams{IncludeTeamsWithoutMembers: true})
+	assert.Nil(t, err, "error retrieving teams")
+	assert.Len(t, res.Teams, 1)
+}
+
 func TestCreateTeam(t *testing.T) {
 	teamTag := "test-team"
 	req := cortex.CreateTeamRequest{
@@ -2,8 +2,10 @@ package cortex
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"gopkg.in/yaml.v3"
+	"io"
 	"math"
 	"net/http"
 	"reflect"
@@ -17,7 +19,7 @@ type yamlDecoder struct{}
 // Decode decodes the Response Body into the value pointed to by v.
 // Caller must provide a non-nil v and close the resp.Body.
 func (d yamlDecoder) Decode(resp *http.Response, v interface{}) error {
-	return yaml.NewDecoder(resp.Body).Decode(v)
+	return ignoreErrorBodyDecodeFailure(resp, yaml.NewDecoder(resp.Body).Decode(v))
 }
 
 // jsonDecoder decodes http response JSON into a JSON-tagged struct value.
@@ -26,7 +28,16 @@ type jsonDecoder struct{}
 // Decode decodes the Response Body into the value pointed to by v.
 // Caller must provide a non-nil v and close the resp.Body.
 func (d jsonDecoder) Decode(resp *http.Response, v interface{}) error {
-	return json.NewDecoder(resp.Body).Decode(v)
+	return ignoreErrorBodyDecodeFailure(resp, json.NewDecoder(resp.Body).Decode(v))
+}
+
+// ignoreErrorBodyDecodeFailure drops decoding errors for empty bodies and for error responses (e.g. an HTML page from
+// a gateway), so that the caller still gets an *ApiError built from the response status.
+func ignoreErrorBodyDecodeFailure(resp *http.Response, err error) error {
+	if err == nil || errors.Is(err, io.EOF) || resp.StatusCode >= 400 {
+		return nil
+	}
+	return err
 }
 
 func MapFetch(m map[string]interface{}, key string, defaultValue any) any {
@@ -58,19 +69,59 @@ func InterfaceToString(v interface{}) (string, error) {
 	return value, nil
 }
 
+// StringToInterface decodes v as a JSON value, so that objects, arrays, numbers, booleans and null keep their type.
+// Anything that is not a single valid JSON value, such as plain text, is returned unchanged as a string.
 func StringToInterface(v string) (interface{}, error) {
-	value := interface{}(nil)
-	if strings.Contains(v, "{") && strings.Contains(v, "}") { // hacky stupid way
-		err := json.Unmarshal([]byte(v), &value)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		value = v
+	value, ok := decodeJSONValue(v)
+	if !ok {
+		return v, nil
 	}
 	return value, nil
 }
 
+// decodeJSONValue decodes v if it holds exactly one JSON value, keeping numbers as json.Number so that they are sent
+// back to the API without losing precision.
+func decodeJSONValue(v string) (interface{}, bool) {
+	decoder := json.NewDecoder(strings.NewReader(v))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, false
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+	return value, true
+}
+
+// StringKeys converts maps decoded from YAML that have keys other than strings, such as the status codes of OpenAPI
+// responses, into maps with string keys, so that they can be encoded as JSON.
+func StringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[k] = StringKeys(item)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = StringKeys(item)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, item := range v {
+			l[i] = StringKeys(item)
+		}
+		return l
+	default:
+		return value
+	}
+}
+
 func AnyToFloat64(unk any) (float64, error) {
 	floatType := reflect.TypeOf(float64(0))
 	stringType := reflect.TypeOf("")
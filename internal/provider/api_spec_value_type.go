@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+	"reflect"
+	"strings"
+)
+
+// Ensure the API spec value types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = ApiSpecValueType{}
+var _ xattr.TypeWithValidate = ApiSpecValueType{}
+var _ basetypes.StringValuableWithSemanticEquals = ApiSpecValue{}
+
+/***********************************************************************************************************************
+ * Type
+ **********************************************************************************************************************/
+
+// ApiSpecValueType is a string type holding an OpenAPI or AsyncAPI spec in YAML or JSON, which is merged into the
+// descriptor of a catalog entity.
+type ApiSpecValueType struct {
+	basetypes.StringType
+}
+
+func (t ApiSpecValueType) String() string {
+	return "ApiSpecValueType"
+}
+
+func (t ApiSpecValueType) Equal(o attr.Type) bool {
+	_, ok := o.(ApiSpecValueType)
+	return ok
+}
+
+func (t ApiSpecValueType) ValueType(ctx context.Context) attr.Value {
+	return ApiSpecValue{}
+}
+
+func (t ApiSpecValueType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ApiSpecValue{StringValue: in}, nil
+}
+
+func (t ApiSpecValueType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return ApiSpecValue{StringValue: stringValue}, nil
+}
+
+func (t ApiSpecValueType) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(valuePath, "Invalid Terraform Value", fmt.Sprintf("Unable to convert the value to a string: %s", err))
+		return diags
+	}
+	if _, err := NewApiSpecValue(value).Spec(); err != nil {
+		diags.AddAttributeError(valuePath, "Invalid API Spec", err.Error())
+	}
+	return diags
+}
+
+/***********************************************************************************************************************
+ * Value
+ **********************************************************************************************************************/
+
+// ApiSpecValue is an OpenAPI or AsyncAPI spec in YAML or JSON. Specs that decode to the same document are semantically
+// equal, ignoring the title and description of their info block, which are replaced by the ones of the entity, so
+// formatting, comments and key order are not reported as drift.
+type ApiSpecValue struct {
+	basetypes.StringValue
+}
+
+func NewApiSpecValue(value string) ApiSpecValue {
+	return ApiSpecValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewApiSpecNull() ApiSpecValue {
+	return ApiSpecValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewApiSpecValueFromApiModel encodes the API spec of an entity as YAML, or returns null when it has none.
+func NewApiSpecValueFromApiModel(spec map[string]interface{}) (ApiSpecValue, error) {
+	if len(spec) == 0 {
+		return NewApiSpecNull(), nil
+	}
+	bytes, err := yaml.Marshal(spec)
+	if err != nil {
+		return ApiSpecValue{}, err
+	}
+	return NewApiSpecValue(string(bytes)), nil
+}
+
+func (v ApiSpecValue) Type(ctx context.Context) attr.Type {
+	return ApiSpecValueType{}
+}
+
+func (v ApiSpecValue) Equal(o attr.Value) bool {
+	other, ok := o.(ApiSpecValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v ApiSpecValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ApiSpecValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := v.normalize()
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	updated, err := newValue.normalize()
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return reflect.DeepEqual(prior, updated), diags
+}
+
+// Spec decodes the spec as it is stored in the descriptor of an entity, with the default OpenAPI version when it has
+// neither an OpenAPI nor an AsyncAPI version. It returns an error for specs that are not a YAML or JSON object, that
+// set x-cortex-* keys in their info block, or that have nothing but a version.
+func (v ApiSpecValue) Spec() (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &doc); err != nil {
+		return nil, fmt.Errorf("spec is not a valid YAML or JSON object: %w", err)
+	}
+	if info, ok := doc["info"].(map[string]interface{}); ok {
+		for k := range info {
+			if strings.HasPrefix(k, "x-cortex-") {
+				return nil, fmt.Errorf("spec sets info.%s, which must be set through the attributes of the entity instead", k)
+			}
+		}
+	}
+	_, hasOpenApi := doc["openapi"]
+	_, hasAsyncApi := doc["asyncapi"]
+	if !hasOpenApi && !hasAsyncApi {
+		doc["openapi"] = cortex.DefaultOpenApiVersion
+	}
+
+	spec := cortex.ApiSpecFromDescriptor(doc)
+	if spec == nil {
+		return nil, errors.New("spec has no keys besides its version, such as paths, channels or components")
+	}
+	return spec, nil
+}
+
+// normalize decodes the spec and passes it through JSON so that equal numbers written differently, such as 1 and 1.0,
+// compare equal.
+func (v ApiSpecValue) normalize() (interface{}, error) {
+	spec, err := v.Spec()
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(bytes, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
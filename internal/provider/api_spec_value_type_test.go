@@ -0,0 +1,80 @@
+package provider_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestApiSpecValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"comments and formatting": {
+			prior:    "# public API\npaths:\n    /a:\n        get:\n            summary: A\n",
+			new:      "paths:\n  /a:\n    get:\n      summary: A\n",
+			expected: true,
+		},
+		"JSON and YAML": {
+			prior:    `{"paths":{"/a":{"get":{"summary":"A"}}}}`,
+			new:      "paths:\n  /a:\n    get:\n      summary: A\n",
+			expected: true,
+		},
+		"status codes": {
+			prior:    "paths:\n  /a:\n    get:\n      responses:\n        200:\n          description: ok\n",
+			new:      "paths:\n  /a:\n    get:\n      responses:\n        \"200\":\n          description: ok\n",
+			expected: true,
+		},
+		"default OpenAPI version": {
+			prior:    "paths: {}\n",
+			new:      "openapi: 3.0.1\npaths: {}\n",
+			expected: true,
+		},
+		"info replaced by the entity": {
+			prior:    "info:\n  title: Payments API\n  version: 1.0.0\npaths: {}\n",
+			new:      "info:\n  version: 1.0.0\npaths: {}\n",
+			expected: true,
+		},
+		"info version changed": {
+			prior:    "info:\n  version: 1.0.0\npaths: {}\n",
+			new:      "info:\n  version: 1.1.0\npaths: {}\n",
+			expected: false,
+		},
+		"path changed": {
+			prior:    "paths:\n  /a:\n    get:\n      summary: A\n",
+			new:      "paths:\n  /b:\n    get:\n      summary: A\n",
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := provider.NewApiSpecValue(test.prior).StringSemanticEquals(context.Background(), provider.NewApiSpecValue(test.new))
+			assert.Empty(t, diags)
+			assert.Equal(t, test.expected, equal)
+		})
+	}
+}
+
+func TestApiSpecValueTypeValidate(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		invalid bool
+	}{
+		"openapi":       {value: "openapi: 3.0.3\npaths: {}\n"},
+		"asyncapi":      {value: "asyncapi: 2.6.0\nchannels: {}\n"},
+		"version only":  {value: "openapi: 3.0.1\n", invalid: true},
+		"x-cortex keys": {value: "info:\n  x-cortex-tag: svc\npaths: {}\n", invalid: true},
+		"invalid YAML":  {value: "paths: [", invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := provider.ApiSpecValueType{}.Validate(context.Background(), tftypes.NewValue(tftypes.String, test.value), path.Root("api_spec"))
+			assert.Equal(t, test.invalid, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogEntitiesDataSource{}
+
+func NewCatalogEntitiesDataSource() datasource.DataSource {
+	return &CatalogEntitiesDataSource{}
+}
+
+// CatalogEntitiesDataSource defines the data source implementation.
+type CatalogEntitiesDataSource struct {
+	client *cortex.HttpClient
+}
+
+func (d *CatalogEntitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entities"
+}
+
+func (d *CatalogEntitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entities data source. Lists the catalog entities matching every given filter.",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"groups": schema.ListAttribute{
+				MarkdownDescription: "Only return entities in at least one of these groups.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"types": schema.ListAttribute{
+				MarkdownDescription: "Only return entities of one of these types, e.g. `service`, `domain` or `team`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"git_repositories": schema.ListAttribute{
+				MarkdownDescription: "Only return entities linked to one of these Git repositories, e.g. `cortexapps/terraform-provider-cortex`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"owners": schema.ListAttribute{
+				MarkdownDescription: "Only return entities owned by one of these teams or groups.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Only return entities whose tag, name or description contains this text.",
+				Optional:            true,
+			},
+			"page": schema.Int64Attribute{
+				MarkdownDescription: "Zero-based page of results to return. If unset, entities on every page are returned.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of entities per page, including when every page is fetched. Defaults to the Cortex API's page size.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Total number of entities matching the filters, across every page.",
+				Computed:            true,
+			},
+			"total_pages": schema.Int64Attribute{
+				MarkdownDescription: "Total number of pages of entities matching the filters.",
+				Computed:            true,
+			},
+			"entities": schema.ListNestedAttribute{
+				MarkdownDescription: "Entities matching the filters, or only those on `page` if it is set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "Tag of the entity.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Human-readable name of the entity.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the entity.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the entity.",
+							Computed:            true,
+						},
+						"groups": schema.ListAttribute{
+							MarkdownDescription: "Groups of the entity.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"owners": schema.SingleNestedAttribute{
+							MarkdownDescription: "Owners of the entity.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"emails": schema.ListAttribute{
+									MarkdownDescription: "Emails of individual owners.",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+								"groups": schema.ListAttribute{
+									MarkdownDescription: "Names of owning teams or groups.",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+								"slack_channels": schema.ListAttribute{
+									MarkdownDescription: "Names of owning Slack channels.",
+									ElementType:         types.StringType,
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogEntitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogEntitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogEntitiesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without a page, list the entities on every page.
+	params := data.ToApiModel()
+	var entitiesResponse *cortex.CatalogEntitiesResponse
+	var err error
+	if data.Page.IsNull() {
+		entitiesResponse, err = d.client.CatalogEntities().List(ctx, &params)
+	} else {
+		entitiesResponse, err = d.client.CatalogEntities().ListPage(ctx, &params)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list catalog entities, got error: %s", err))
+		return
+	}
+	data.FromApiModel(entitiesResponse)
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
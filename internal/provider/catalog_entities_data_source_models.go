@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntitiesDataSourceModel describes the data source data model.
+type CatalogEntitiesDataSourceModel struct {
+	Id              types.String                           `tfsdk:"id"`
+	Groups          []types.String                         `tfsdk:"groups"`
+	Types           []types.String                         `tfsdk:"types"`
+	GitRepositories []types.String                         `tfsdk:"git_repositories"`
+	Owners          []types.String                         `tfsdk:"owners"`
+	Query           types.String                           `tfsdk:"query"`
+	Page            types.Int64                            `tfsdk:"page"`
+	PageSize        types.Int64                            `tfsdk:"page_size"`
+	Total           types.Int64                            `tfsdk:"total"`
+	TotalPages      types.Int64                            `tfsdk:"total_pages"`
+	Entities        []CatalogEntitiesDataSourceEntityModel `tfsdk:"entities"`
+}
+
+func (o *CatalogEntitiesDataSourceModel) ToApiModel() cortex.CatalogEntityListParams {
+	return cortex.CatalogEntityListParams{
+		Groups:          stringValues(o.Groups),
+		Types:           stringValues(o.Types),
+		GitRepositories: stringValues(o.GitRepositories),
+		Owners:          stringValues(o.Owners),
+		Query:           o.Query.ValueString(),
+		Page:            int(o.Page.ValueInt64()),
+		PageSize:        int(o.PageSize.ValueInt64()),
+	}
+}
+
+func (o *CatalogEntitiesDataSourceModel) FromApiModel(response *cortex.CatalogEntitiesResponse) {
+	o.Id = types.StringValue("catalog_entities")
+	o.Total = types.Int64Value(int64(response.Total))
+	o.TotalPages = types.Int64Value(int64(response.TotalPages))
+	o.Entities = make([]CatalogEntitiesDataSourceEntityModel, len(response.Entities))
+	for i, entity := range response.Entities {
+		e := CatalogEntitiesDataSourceEntityModel{}
+		o.Entities[i] = e.FromApiModel(&entity)
+	}
+}
+
+/***********************************************************************************************************************
+ * Entities
+ **********************************************************************************************************************/
+
+type CatalogEntitiesDataSourceEntityModel struct {
+	Tag         types.String                         `tfsdk:"tag"`
+	Title       types.String                         `tfsdk:"title"`
+	Description types.String                         `tfsdk:"description"`
+	Type        types.String                         `tfsdk:"type"`
+	Groups      []types.String                       `tfsdk:"groups"`
+	Owners      CatalogEntitiesDataSourceOwnersModel `tfsdk:"owners"`
+}
+
+func (o *CatalogEntitiesDataSourceEntityModel) FromApiModel(entity *cortex.CatalogEntity) CatalogEntitiesDataSourceEntityModel {
+	owners := CatalogEntitiesDataSourceOwnersModel{}
+	return CatalogEntitiesDataSourceEntityModel{
+		Tag:         types.StringValue(entity.Tag),
+		Title:       types.StringValue(entity.Title),
+		Description: types.StringValue(entity.Description),
+		Type:        types.StringValue(entity.Type),
+		Groups:      stringValuesFrom(entity.Groups),
+		Owners:      owners.FromApiModel(&entity.Ownership),
+	}
+}
+
+type CatalogEntitiesDataSourceOwnersModel struct {
+	Emails        []types.String `tfsdk:"emails"`
+	Groups        []types.String `tfsdk:"groups"`
+	SlackChannels []types.String `tfsdk:"slack_channels"`
+}
+
+func (o *CatalogEntitiesDataSourceOwnersModel) FromApiModel(ownership *cortex.CatalogEntityOwnership) CatalogEntitiesDataSourceOwnersModel {
+	owners := CatalogEntitiesDataSourceOwnersModel{
+		Emails:        make([]types.String, len(ownership.Emails)),
+		Groups:        make([]types.String, len(ownership.Groups)),
+		SlackChannels: make([]types.String, len(ownership.SlackChannels)),
+	}
+	for i, email := range ownership.Emails {
+		owners.Emails[i] = types.StringValue(email.Email)
+	}
+	for i, group := range ownership.Groups {
+		owners.Groups[i] = types.StringValue(group.GroupName)
+	}
+	for i, channel := range ownership.SlackChannels {
+		owners.SlackChannels[i] = types.StringValue(channel.Channel)
+	}
+	return owners
+}
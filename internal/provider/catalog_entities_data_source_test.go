@@ -0,0 +1,49 @@
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCatalogEntitiesDataSource(t *testing.T) {
+	recordName := "data.cortex_catalog_entities.services"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCatalogEntitiesDataSourceBasic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(recordName, "total", "1"),
+					resource.TestCheckResourceAttr(recordName, "entities.#", "1"),
+					resource.TestCheckResourceAttr(recordName, "entities.0.tag", "manual-test"),
+					resource.TestCheckResourceAttr(recordName, "entities.0.title", "Manual Test Service"),
+					resource.TestCheckResourceAttr(recordName, "entities.0.type", "service"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entities.none", "entities.#", "0"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entities.first_page", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entities.first_page", "total_pages", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCatalogEntitiesDataSourceBasic() string {
+	return `
+data "cortex_catalog_entities" "services" {
+	types = ["service"]
+	query = "manual test service"
+}
+
+data "cortex_catalog_entities" "first_page" {
+	types     = ["service"]
+	page      = 0
+	page_size = 1
+}
+
+data "cortex_catalog_entities" "none" {
+	types = ["service"]
+	query = "no entity has this in its name"
+}`
+}
@@ -93,7 +93,7 @@ func (d *CatalogEntityCustomDataDataSource) Read(ctx context.Context, req dataso
 	// Issue API request
 	entity, err := d.client.CatalogEntityCustomData().Get(ctx, data.Tag.ValueString(), data.Key.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read example, got error: %s", err))
 		return
 	}
 
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogEntityCustomDataListDataSource{}
+
+func NewCatalogEntityCustomDataListDataSource() datasource.DataSource {
+	return &CatalogEntityCustomDataListDataSource{}
+}
+
+// CatalogEntityCustomDataListDataSource defines the data source implementation.
+type CatalogEntityCustomDataListDataSource struct {
+	client *cortex.HttpClient
+}
+
+func (d *CatalogEntityCustomDataListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_custom_data_list"
+}
+
+func (d *CatalogEntityCustomDataListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Custom Data List data source. Lists every custom data entry on a catalog entity.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the catalog entity",
+				Required:            true,
+			},
+
+			// Optional
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Only return custom data from this source, e.g. `API` for data set through the API or `YAML` for data set in the entity's descriptor.",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"custom_data": schema.MapNestedAttribute{
+				MarkdownDescription: "Custom data on the entity, keyed by key.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Key of the custom data entry.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the custom data entry.",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Source of the custom data entry.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the custom data entry. Objects and lists are JSON-encoded, other values are rendered as strings.",
+							Computed:            true,
+						},
+						"value_json": schema.StringAttribute{
+							MarkdownDescription: "Value of the custom data entry encoded as JSON, so that `jsondecode` returns it with its original type.",
+							Computed:            true,
+						},
+						"date_updated": schema.StringAttribute{
+							MarkdownDescription: "When the custom data entry was last updated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogEntityCustomDataListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogEntityCustomDataListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogEntityCustomDataListDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := cortex.CatalogEntityCustomDataListParams{Source: data.Source.ValueString()}
+	entities, err := d.client.CatalogEntityCustomData().List(ctx, data.Tag.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list catalog entity custom data, got error: %s", err))
+		return
+	}
+	data.FromApiModel(&resp.Diagnostics, entities)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogEntityCustomDataListDataSourceModel describes the data source data model.
+type CatalogEntityCustomDataListDataSourceModel struct {
+	Id         types.String                                     `tfsdk:"id"`
+	Tag        types.String                                     `tfsdk:"tag"`
+	Source     types.String                                     `tfsdk:"source"`
+	CustomData map[string]CatalogEntityCustomDataListEntryModel `tfsdk:"custom_data"`
+}
+
+type CatalogEntityCustomDataListEntryModel struct {
+	Key         types.String `tfsdk:"key"`
+	Description types.String `tfsdk:"description"`
+	Source      types.String `tfsdk:"source"`
+	Value       types.String `tfsdk:"value"`
+	ValueJson   types.String `tfsdk:"value_json"`
+	DateUpdated types.String `tfsdk:"date_updated"`
+}
+
+func (o *CatalogEntityCustomDataListDataSourceModel) FromApiModel(diagnostics *diag.Diagnostics, entities []cortex.CatalogEntityCustomData) {
+	o.Id = o.Tag
+	o.CustomData = make(map[string]CatalogEntityCustomDataListEntryModel, len(entities))
+	for _, entity := range entities {
+		value, err := entity.ValueAsString()
+		if err != nil {
+			diagnostics.AddError("Error parsing value", fmt.Sprintf("Unable to read the value of custom data %s: %s", entity.Key, err))
+			return
+		}
+		valueJson, err := json.Marshal(entity.Value)
+		if err != nil {
+			diagnostics.AddError("Error parsing value", fmt.Sprintf("Unable to encode the value of custom data %s as JSON: %s", entity.Key, err))
+			return
+		}
+		o.CustomData[entity.Key] = CatalogEntityCustomDataListEntryModel{
+			Key:         types.StringValue(entity.Key),
+			Description: types.StringValue(entity.Description),
+			Source:      types.StringValue(entity.Source),
+			Value:       types.StringValue(value),
+			ValueJson:   types.StringValue(string(valueJson)),
+			DateUpdated: types.StringValue(entity.DateUpdated),
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCatalogEntityCustomDataListDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCatalogEntityCustomDataListDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.all", "tag", "manual-test"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.all", "custom_data.manual.source", "YAML"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.all", "custom_data.manual.value_json", `{"test":"one","things":["two","three"]}`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.all", "custom_data.manual-api.source", "API"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.all", "custom_data.manual-api.value_json", `{"enabled":true}`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.api", "custom_data.%", "1"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.api", "custom_data.manual-api.key", "manual-api"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCatalogEntityCustomDataListDataSourceConfig = `
+data "cortex_catalog_entity_custom_data_list" "all" {
+  tag = "manual-test"
+}
+
+data "cortex_catalog_entity_custom_data_list" "api" {
+  tag    = "manual-test"
+  source = "API"
+}
+`
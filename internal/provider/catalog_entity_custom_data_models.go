@@ -12,11 +12,11 @@ import (
  **********************************************************************************************************************/
 
 type CatalogEntityCustomDataResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Tag         types.String `tfsdk:"tag"`
-	Key         types.String `tfsdk:"key"`
-	Description types.String `tfsdk:"description"`
-	Value       types.String `tfsdk:"value"`
+	Id          types.String    `tfsdk:"id"`
+	Tag         types.String    `tfsdk:"tag"`
+	Key         types.String    `tfsdk:"key"`
+	Description types.String    `tfsdk:"description"`
+	Value       CustomDataValue `tfsdk:"value"`
 }
 
 func (r *CatalogEntityCustomDataResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.CatalogEntityCustomData) {
@@ -34,7 +34,7 @@ func (r *CatalogEntityCustomDataResourceModel) FromApiModel(ctx context.Context,
 		diagnostics.AddError("Error parsing value: %s", err.Error())
 		return
 	}
-	r.Value = types.StringValue(value)
+	r.Value = NewCustomDataValue(value)
 }
 
 func (r *CatalogEntityCustomDataResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.CatalogEntityCustomData {
@@ -44,19 +44,9 @@ func (r *CatalogEntityCustomDataResourceModel) ToApiModel(diagnostics *diag.Diag
 		Description: r.Description.ValueString(),
 	}
 
-	var value interface{}
-	if !r.Value.IsNull() && !r.Value.IsUnknown() && r.Value.ValueString() != "" {
-		err := error(nil)
-		value, err = cortex.StringToInterface(r.Value.ValueString())
-		if err != nil {
-			diagnostics.AddError(
-				"Unable to Convert Custom Data Value",
-				"An unexpected result occurred when deserializing the value from JSON. Please ensure your value is valid JSON.",
-			)
-			value = ""
-		}
-	} else {
-		value = ""
+	var value interface{} = ""
+	if !r.Value.IsNull() && !r.Value.IsUnknown() {
+		value, _ = cortex.StringToInterface(r.Value.ValueString())
 	}
 	entity.Value = value
 
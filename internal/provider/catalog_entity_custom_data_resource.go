@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -59,8 +60,9 @@ func (r *CatalogEntityCustomDataResource) Schema(ctx context.Context, req resour
 				Required:            true,
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value for the custom data. Must be a JSON-encoded string.",
+				MarkdownDescription: customDataValueDescription,
 				Required:            true,
+				CustomType:          CustomDataValueType{},
 			},
 
 			// Optional attributes
@@ -117,7 +119,11 @@ func (r *CatalogEntityCustomDataResource) Read(ctx context.Context, req resource
 	// Issue API request
 	entity, err := r.client.CatalogEntityCustomData().Get(ctx, data.Tag.ValueString(), data.Key.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity custom data, got error: %s", err))
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity custom data, got error: %s", err))
 		return
 	}
 
@@ -148,7 +154,7 @@ func (r *CatalogEntityCustomDataResource) Create(ctx context.Context, req resour
 
 	entity, err := r.client.CatalogEntityCustomData().Upsert(ctx, clientEntity.Tag, clientEntity.ToUpsertRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog entity custom data, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create catalog entity custom data, got error: %s", err))
 		return
 	}
 
@@ -178,7 +184,7 @@ func (r *CatalogEntityCustomDataResource) Update(ctx context.Context, req resour
 
 	entity, err := r.client.CatalogEntityCustomData().Upsert(ctx, clientEntity.Tag, clientEntity.ToUpsertRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entity custom data, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update catalog entity custom data, got error: %s", err))
 		return
 	}
 
@@ -204,7 +210,7 @@ func (r *CatalogEntityCustomDataResource) Delete(ctx context.Context, req resour
 
 	err := r.client.CatalogEntityCustomData().Delete(ctx, data.Tag.ValueString(), data.Key.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entity custom data, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity custom data, got error: %s", err))
 		return
 	}
 }
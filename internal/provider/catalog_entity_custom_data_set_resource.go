@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityCustomDataSetResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityCustomDataSetResource{}
+
+func NewCatalogEntityCustomDataSetResource() resource.Resource {
+	return &CatalogEntityCustomDataSetResource{}
+}
+
+func NewCatalogEntityCustomDataSetResourceModel() CatalogEntityCustomDataSetResourceModel {
+	return CatalogEntityCustomDataSetResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityCustomDataSetResource manages all the custom data on a catalog entity, deleting any keys that are not in
+// its configuration.
+type CatalogEntityCustomDataSetResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityCustomDataSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_custom_data_set"
+}
+
+func (r *CatalogEntityCustomDataSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritative set of the custom data on a catalog entity. Keys that are not in `custom_data` are deleted from the entity.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The Catalog Entity tag for this custom data.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_data": schema.MapNestedAttribute{
+				MarkdownDescription: "Custom data entries on the catalog entity, keyed by the key of each entry. Keys set by other sources, such as the entity's descriptor, cannot be included.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: customDataValueDescription,
+							Required:            true,
+							CustomType:          CustomDataValueType{},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the custom data.",
+							Optional:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Where the custom data was last set from, such as `API` or `YAML`.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+
+			// Optional attributes
+			"prune_api_source_only": schema.BoolAttribute{
+				MarkdownDescription: "Only delete keys that are not in `custom_data` if they were set through the API, leaving those from other sources such as the entity's descriptor. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityCustomDataSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityCustomDataSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityCustomDataSetResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entities, err := r.client.CatalogEntityCustomData().List(ctx, data.Tag.ValueString(), cortex.CatalogEntityCustomDataListParams{})
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity custom data, got error: %s", err))
+		return
+	}
+
+	// Imported resources have no prior value for attributes with defaults
+	if data.PruneApiSourceOnly.IsNull() {
+		data.PruneApiSourceOnly = types.BoolValue(false)
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, data.Tag.ValueString(), entities)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityCustomDataSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityCustomDataSetResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityCustomDataSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityCustomDataSetResourceModel()
+	state := NewCatalogEntityCustomDataSetResourceModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, state.CustomData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply upserts the entries in data that differ from those in current, deletes the prunable keys on the entity that are
+// not in data, and then sets data from the custom data left on the entity.
+func (r *CatalogEntityCustomDataSetResource) apply(ctx context.Context, data *CatalogEntityCustomDataSetResourceModel, current map[string]CatalogEntityCustomDataSetEntryModel, diagnostics *diag.Diagnostics) {
+	tag := data.Tag.ValueString()
+
+	// Keys set by another source, such as the entity's descriptor, keep the value from that source, which would never
+	// match the configuration.
+	existing, err := r.client.CatalogEntityCustomData().List(ctx, tag, cortex.CatalogEntityCustomDataListParams{})
+	if err != nil {
+		diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity custom data, got error: %s", err))
+		return
+	}
+	for _, entity := range existing {
+		if _, ok := data.CustomData[entity.Key]; ok && !strings.EqualFold(entity.Source, cortex.CatalogEntityCustomDataSourceApi) {
+			diagnostics.AddAttributeError(
+				path.Root("custom_data").AtMapKey(entity.Key),
+				"Custom Data Managed By Another Source",
+				fmt.Sprintf("Custom data %s on catalog entity %s is set by source %s, so it cannot be managed by Terraform. Remove it from either custom_data or that source.", entity.Key, tag, entity.Source),
+			)
+		}
+	}
+	if diagnostics.HasError() {
+		return
+	}
+
+	for key, entry := range data.CustomData {
+		if existing, ok := current[key]; ok && existing.Equal(ctx, entry) {
+			continue
+		}
+		upsertRequest := entry.ToUpsertRequest(diagnostics, key)
+		if diagnostics.HasError() {
+			return
+		}
+		if _, err := r.client.CatalogEntityCustomData().Upsert(ctx, tag, upsertRequest); err != nil {
+			diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upsert catalog entity custom data %s, got error: %s", key, err))
+			return
+		}
+	}
+
+	entities, err := r.client.CatalogEntityCustomData().List(ctx, tag, cortex.CatalogEntityCustomDataListParams{})
+	if err != nil {
+		diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity custom data, got error: %s", err))
+		return
+	}
+
+	remaining := make([]cortex.CatalogEntityCustomData, 0, len(entities))
+	for _, entity := range entities {
+		if _, ok := data.CustomData[entity.Key]; ok || !data.Prunable(entity) {
+			remaining = append(remaining, entity)
+			continue
+		}
+		if err := r.client.CatalogEntityCustomData().Delete(ctx, tag, entity.Key); err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity custom data %s, got error: %s", entity.Key, err))
+			return
+		}
+	}
+
+	data.FromApiModel(diagnostics, tag, remaining)
+}
+
+func (r *CatalogEntityCustomDataSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityCustomDataSetResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for key := range data.CustomData {
+		err := r.client.CatalogEntityCustomData().Delete(ctx, data.Tag.ValueString(), key)
+		if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity custom data %s, got error: %s", key, err))
+			return
+		}
+	}
+}
+
+func (r *CatalogEntityCustomDataSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
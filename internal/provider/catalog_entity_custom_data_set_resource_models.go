@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityCustomDataSetResourceModel describes every custom data entry on one catalog entity.
+type CatalogEntityCustomDataSetResourceModel struct {
+	Id                 types.String                                    `tfsdk:"id"`
+	Tag                types.String                                    `tfsdk:"tag"`
+	PruneApiSourceOnly types.Bool                                      `tfsdk:"prune_api_source_only"`
+	CustomData         map[string]CatalogEntityCustomDataSetEntryModel `tfsdk:"custom_data"`
+}
+
+// Prunable reports whether a custom data entry that is not in the configuration should be deleted.
+func (r *CatalogEntityCustomDataSetResourceModel) Prunable(entity cortex.CatalogEntityCustomData) bool {
+	return !r.PruneApiSourceOnly.ValueBool() || strings.EqualFold(entity.Source, cortex.CatalogEntityCustomDataSourceApi)
+}
+
+// FromApiModel sets CustomData to the entries of entities that are already managed, or that are prunable and so will be
+// deleted on the next apply, and warns about managed entries that were last set by something other than the API.
+func (r *CatalogEntityCustomDataSetResourceModel) FromApiModel(diagnostics *diag.Diagnostics, tag string, entities []cortex.CatalogEntityCustomData) {
+	r.Id = types.StringValue(tag)
+	r.Tag = types.StringValue(tag)
+
+	customData := map[string]CatalogEntityCustomDataSetEntryModel{}
+	for _, entity := range entities {
+		_, managed := r.CustomData[entity.Key]
+		if !managed && !r.Prunable(entity) {
+			continue
+		}
+		if managed && !strings.EqualFold(entity.Source, cortex.CatalogEntityCustomDataSourceApi) {
+			diagnostics.AddWarning(
+				"Custom Data Changed Outside Terraform",
+				fmt.Sprintf("Custom data %s on catalog entity %s was last set by source %s, so its value may differ from the configuration.", entity.Key, tag, entity.Source),
+			)
+		}
+		e := CatalogEntityCustomDataSetEntryModel{}
+		customData[entity.Key] = e.FromApiModel(diagnostics, entity)
+	}
+	r.CustomData = customData
+}
+
+/***********************************************************************************************************************
+ * Entries
+ **********************************************************************************************************************/
+
+type CatalogEntityCustomDataSetEntryModel struct {
+	Value       CustomDataValue `tfsdk:"value"`
+	Description types.String    `tfsdk:"description"`
+	Source      types.String    `tfsdk:"source"`
+}
+
+func (o *CatalogEntityCustomDataSetEntryModel) FromApiModel(diagnostics *diag.Diagnostics, entity cortex.CatalogEntityCustomData) CatalogEntityCustomDataSetEntryModel {
+	value, err := entity.ValueAsString()
+	if err != nil {
+		diagnostics.AddError("Error parsing value", fmt.Sprintf("Unable to read the value of custom data %s: %s", entity.Key, err))
+	}
+	return CatalogEntityCustomDataSetEntryModel{
+		Value:       NewCustomDataValue(value),
+		Description: stringValueOrNull(entity.Description),
+		Source:      types.StringValue(entity.Source),
+	}
+}
+
+// Equal reports whether two entries have semantically equal values and the same description, ignoring where they came
+// from.
+func (o *CatalogEntityCustomDataSetEntryModel) Equal(ctx context.Context, other CatalogEntityCustomDataSetEntryModel) bool {
+	equal, _ := o.Value.StringSemanticEquals(ctx, other.Value)
+	return equal && o.Description.ValueString() == other.Description.ValueString()
+}
+
+func (o *CatalogEntityCustomDataSetEntryModel) ToUpsertRequest(diagnostics *diag.Diagnostics, key string) cortex.UpsertCatalogEntityCustomDataRequest {
+	entry := CatalogEntityCustomDataResourceModel{
+		Key:         types.StringValue(key),
+		Description: o.Description,
+		Value:       o.Value,
+	}
+	entity := entry.ToApiModel(diagnostics)
+	return entity.ToUpsertRequest()
+}
@@ -0,0 +1,96 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCatalogEntityCustomDataSetResource(t *testing.T) {
+	resourceName := "cortex_catalog_entity_custom_data_set.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityCustomDataSetResourceConfig(`
+    alpha = {
+      value       = "one"
+      description = "The first key"
+    }
+    bravo = {
+      value = jsonencode({ enabled = true })
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "test-custom-data-set"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.alpha.value", "one"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.alpha.description", "The first key"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.alpha.source", "API"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.bravo.value", `{"enabled":true}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "test-custom-data-set",
+				ImportStateVerify: true,
+				// Imported sets prune every key, so they also pick up the entity's descriptor metadata.
+				ImportStateVerifyIgnore: []string{"prune_api_source_only", "custom_data.%", "custom_data.from-yaml"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityCustomDataSetResourceConfig(`
+    alpha = {
+      value = "two"
+    }
+`) + `
+data "cortex_catalog_entity_custom_data_list" "test" {
+  tag        = "test-custom-data-set"
+  depends_on = [cortex_catalog_entity_custom_data_set.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_data.alpha.value", "two"),
+					resource.TestCheckNoResourceAttr(resourceName, "custom_data.alpha.description"),
+					resource.TestCheckNoResourceAttr("data.cortex_catalog_entity_custom_data_list.test", "custom_data.bravo.key"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.test", "custom_data.from-yaml.source", "YAML"),
+				),
+			},
+			// Keys set in the entity's descriptor cannot be managed
+			{
+				Config: testAccCatalogEntityCustomDataSetResourceConfig(`
+    from-yaml = {
+      value = "overridden"
+    }
+`),
+				ExpectError: regexp.MustCompile("Custom Data Managed By Another Source"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityCustomDataSetResourceConfig(customData string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test" {
+  tag      = "test-custom-data-set"
+  name     = "Custom data set service"
+  metadata = jsonencode({
+    from-yaml = "kept"
+  })
+}
+
+resource "cortex_catalog_entity_custom_data_set" "test" {
+  tag                   = cortex_catalog_entity.test.tag
+  prune_api_source_only = true
+
+  custom_data = {%s  }
+}
+`, customData)
+}
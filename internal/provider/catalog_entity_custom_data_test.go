@@ -143,3 +143,49 @@ func TestAccCatalogEntityCustomDataCompleteResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCatalogEntityCustomDataTypedValues(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Values keep their JSON type, and whitespace in the configuration is not drift
+			{
+				Config: `
+resource "cortex_catalog_entity_custom_data" "number" {
+  tag   = "manual-test"
+  key   = "test-custom-data-number"
+  value = "3"
+}
+
+resource "cortex_catalog_entity_custom_data" "string" {
+  tag   = "manual-test"
+  key   = "test-custom-data-string"
+  value = jsonencode("3")
+}
+
+resource "cortex_catalog_entity_custom_data" "list" {
+  tag   = "manual-test"
+  key   = "test-custom-data-list"
+  value = "[ true, \"a {b}\" ]"
+}
+
+data "cortex_catalog_entity_custom_data_list" "test" {
+  tag = "manual-test"
+  depends_on = [
+    cortex_catalog_entity_custom_data.number,
+    cortex_catalog_entity_custom_data.string,
+    cortex_catalog_entity_custom_data.list,
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cortex_catalog_entity_custom_data.list", "value", `[ true, "a {b}" ]`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.test", "custom_data.test-custom-data-number.value_json", `3`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.test", "custom_data.test-custom-data-string.value_json", `"3"`),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_custom_data_list.test", "custom_data.test-custom-data-list.value_json", `[true,"a {b}"]`),
+				),
+			},
+		},
+	})
+}
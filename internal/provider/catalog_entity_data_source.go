@@ -101,7 +101,7 @@ func (d *CatalogEntityDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Issue API request
 	entity, err := d.client.CatalogEntities().GetFromDescriptor(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read example, got error: %s", err))
 		return
 	}
 
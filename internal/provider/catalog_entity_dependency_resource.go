@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityDependencyResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityDependencyResource{}
+
+func NewCatalogEntityDependencyResource() resource.Resource {
+	return &CatalogEntityDependencyResource{}
+}
+
+func NewCatalogEntityDependencyEdgeResourceModel() CatalogEntityDependencyEdgeResourceModel {
+	return CatalogEntityDependencyEdgeResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityDependencyResource manages one dependency between two catalog entities through the dependency
+// endpoints, so that it can be owned apart from the caller's cortex_catalog_entity.
+type CatalogEntityDependencyResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDependencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_dependency"
+}
+
+func (r *CatalogEntityDependencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Dependency of one catalog entity on another, optionally on one of its endpoints. " +
+			"Unlike the `dependencies` attribute of `cortex_catalog_entity`, it does not change the caller's descriptor, so each team can manage its outgoing dependencies on its own. " +
+			"Do not also declare the same dependency in the caller's `dependencies` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"caller_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the entity that depends on the callee.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"callee_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the entity that the caller depends on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional attributes
+			"method": schema.StringAttribute{
+				MarkdownDescription: "HTTP method of the endpoint of the callee, if depending on a specific endpoint. Must be set with `path`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("path")),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the endpoint of the callee, if depending on a specific endpoint. Must be set with `method`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("method")),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the dependency.",
+				Optional:            true,
+			},
+			"metadata": schema.StringAttribute{
+				MarkdownDescription: "Custom metadata for the dependency, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
+				Optional:            true,
+				CustomType:          JSONValueType{},
+				Validators: []validator.String{
+					JSONObjectValidator{},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				MarkdownDescription: "`caller_tag:callee_tag`, followed by `:method:path` for dependencies on an endpoint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDependencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	dependency, err := r.client.Dependencies().Get(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.Params())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity dependency, got error: %s", err))
+		return
+	}
+
+	// The endpoint may not echo the request, so keep the identity of the dependency from state
+	dependency.CallerTag = data.CallerTag.ValueString()
+	dependency.CalleeTag = data.CalleeTag.ValueString()
+	dependency.Method = data.Method.ValueString()
+	dependency.Path = data.Path.ValueString()
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, &dependency)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upsertRequest := data.ToUpsertRequest(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependency := data.ToApiModel(&resp.Diagnostics)
+
+	// Issue API request
+	_, err := r.client.Dependencies().Create(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.Params(), upsertRequest)
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorConflict) {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create catalog entity dependency, it already exists and can be imported with the ID %q: %s", dependency.ID(), err))
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create catalog entity dependency, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(dependency.ID())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upsertRequest := data.ToUpsertRequest(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	_, err := r.client.Dependencies().Update(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.Params(), upsertRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update catalog entity dependency, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityDependencyEdgeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Dependencies().Delete(ctx, data.CallerTag.ValueString(), data.CalleeTag.ValueString(), data.Params())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity dependency, got error: %s", err))
+		return
+	}
+}
+
+// ImportState imports a dependency by its ID, caller_tag:callee_tag or caller_tag:callee_tag:method:path. The path is
+// last, so it may contain colons.
+func (r *CatalogEntityDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, ":", 4)
+
+	if (len(idParts) != 2 && len(idParts) != 4) || slices.Contains(idParts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: caller_tag:callee_tag or caller_tag:callee_tag:method:path. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("caller_tag"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("callee_tag"), idParts[1])...)
+	if len(idParts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("method"), idParts[2])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), idParts[3])...)
+	}
+}
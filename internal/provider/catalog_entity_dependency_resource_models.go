@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityDependencyEdgeResourceModel describes one dependency from a caller entity to a callee entity, managed
+// apart from the descriptor of the caller. CatalogEntityDependencyResourceModel describes the ones in the descriptor.
+type CatalogEntityDependencyEdgeResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	CallerTag   types.String `tfsdk:"caller_tag"`
+	CalleeTag   types.String `tfsdk:"callee_tag"`
+	Method      types.String `tfsdk:"method"`
+	Path        types.String `tfsdk:"path"`
+	Description types.String `tfsdk:"description"`
+	Metadata    JSONValue    `tfsdk:"metadata"`
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.Dependency {
+	var metadata map[string]interface{}
+	if !o.Metadata.IsNull() && !o.Metadata.IsUnknown() && o.Metadata.ValueString() != "" {
+		if err := json.Unmarshal([]byte(o.Metadata.ValueString()), &metadata); err != nil {
+			diagnostics.AddError("error parsing dependency metadata", fmt.Sprintf("%+v", err))
+		}
+	}
+
+	return cortex.Dependency{
+		CallerTag:   o.CallerTag.ValueString(),
+		CalleeTag:   o.CalleeTag.ValueString(),
+		Method:      o.Method.ValueString(),
+		Path:        o.Path.ValueString(),
+		Description: o.Description.ValueString(),
+		Metadata:    metadata,
+	}
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) FromApiModel(diagnostics *diag.Diagnostics, dependency *cortex.Dependency) {
+	o.Id = types.StringValue(dependency.ID())
+	o.CallerTag = types.StringValue(dependency.CallerTag)
+	o.CalleeTag = types.StringValue(dependency.CalleeTag)
+	o.Method = stringValueOrNull(dependency.Method)
+	o.Path = stringValueOrNull(dependency.Path)
+	o.Description = stringValueOrNull(dependency.Description)
+
+	if len(dependency.Metadata) > 0 {
+		metadata, err := json.Marshal(dependency.Metadata)
+		if err != nil {
+			diagnostics.AddError("error marshalling dependency metadata", fmt.Sprintf("%+v", err))
+			return
+		}
+		o.Metadata = NewJSONValue(string(metadata))
+	} else {
+		o.Metadata = NewJSONNull()
+	}
+}
+
+// Params returns the endpoint of the callee that the dependency is on.
+func (o *CatalogEntityDependencyEdgeResourceModel) Params() cortex.DependencyParams {
+	return cortex.DependencyParams{
+		Method: o.Method.ValueString(),
+		Path:   o.Path.ValueString(),
+	}
+}
+
+func (o *CatalogEntityDependencyEdgeResourceModel) ToUpsertRequest(diagnostics *diag.Diagnostics) cortex.UpsertDependencyRequest {
+	dependency := o.ToApiModel(diagnostics)
+	return cortex.UpsertDependencyRequest{
+		Description: dependency.Description,
+		Metadata:    dependency.Metadata,
+	}
+}
@@ -0,0 +1,82 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccCatalogEntityDependencyResource(t *testing.T) {
+	resourceName := "cortex_catalog_entity_dependency.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityDependencyResourceConfig(`
+  description = "Lists products"
+  metadata = jsonencode({
+    tier = "critical"
+  })
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "test-dependency-caller:test-dependency-callee:GET:/v1/products"),
+					resource.TestCheckResourceAttr(resourceName, "caller_tag", "test-dependency-caller"),
+					resource.TestCheckResourceAttr(resourceName, "callee_tag", "test-dependency-callee"),
+					resource.TestCheckResourceAttr(resourceName, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "path", "/v1/products"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Lists products"),
+					resource.TestCheckResourceAttr(resourceName, "metadata", `{"tier":"critical"}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "test-dependency-caller:test-dependency-callee:GET:/v1/products",
+				ImportStateVerify: true,
+			},
+			// Metadata that is not a JSON object is rejected when planning
+			{
+				Config: testAccCatalogEntityDependencyResourceConfig(`
+  metadata = jsonencode(["critical"])
+`),
+				ExpectError: regexp.MustCompile("Invalid JSON Object Value"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityDependencyResourceConfig(`
+  description = "Lists every product"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Lists every product"),
+					resource.TestCheckNoResourceAttr(resourceName, "metadata"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityDependencyResourceConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "caller" {
+  tag  = "test-dependency-caller"
+  name = "Dependency caller"
+}
+
+resource "cortex_catalog_entity" "callee" {
+  tag  = "test-dependency-callee"
+  name = "Dependency callee"
+}
+
+resource "cortex_catalog_entity_dependency" "test" {
+  caller_tag = cortex_catalog_entity.caller.tag
+  callee_tag = cortex_catalog_entity.callee.tag
+  method     = "GET"
+  path       = "/v1/products"
+%s}
+`, attributes)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityDescriptorResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityDescriptorResource{}
+
+func NewCatalogEntityDescriptorResource() resource.Resource {
+	return &CatalogEntityDescriptorResource{}
+}
+
+func NewCatalogEntityDescriptorResourceModel() CatalogEntityDescriptorResourceModel {
+	return CatalogEntityDescriptorResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityDescriptorResource manages a catalog entity through its raw OpenAPI descriptor, so that every
+// x-cortex-* key is supported, including ones that cortex_catalog_entity does not have attributes for.
+type CatalogEntityDescriptorResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDescriptorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_descriptor"
+}
+
+func (r *CatalogEntityDescriptorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity managed through its raw OpenAPI descriptor, such as a `cortex.yaml` file.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"descriptor": schema.StringAttribute{
+				MarkdownDescription: "OpenAPI descriptor of the entity in YAML or JSON, such as from the `file` or `yamlencode` functions. " +
+					"It must have an `info` block with an `x-cortex-tag`, and `openapi` defaults to `" + cortex.DefaultOpenApiVersion + "`. " +
+					"Formatting, comments and key order are ignored when comparing it with the descriptor stored in Cortex.",
+				Required:   true,
+				CustomType: DescriptorValueType{},
+			},
+
+			// Computed attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the entity, from `info.x-cortex-tag` in the descriptor. Changing it replaces the entity.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					descriptorTagPlanModifier{},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					descriptorTagPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+// descriptorTagPlanModifier plans an attribute as the tag of the planned descriptor, and requires the resource to be
+// replaced when the tag changes, since it identifies the entity.
+type descriptorTagPlanModifier struct{}
+
+func (m descriptorTagPlanModifier) Description(ctx context.Context) string {
+	return "Set to the x-cortex-tag of the descriptor, and requires replacement when it changes."
+}
+
+func (m descriptorTagPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return "Set to the `x-cortex-tag` of the descriptor, and requires replacement when it changes."
+}
+
+func (m descriptorTagPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var descriptor DescriptorValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("descriptor"), &descriptor)...)
+	if resp.Diagnostics.HasError() || descriptor.IsNull() || descriptor.IsUnknown() {
+		return
+	}
+
+	tag, err := descriptor.Tag()
+	if err != nil {
+		// The descriptor attribute reports invalid descriptors.
+		return
+	}
+	resp.PlanValue = types.StringValue(tag)
+	if !req.StateValue.IsNull() && req.StateValue.ValueString() != tag {
+		resp.RequiresReplace = true
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityDescriptorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityDescriptorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityDescriptorResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	descriptor, err := r.client.CatalogEntities().GetDescriptor(ctx, data.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity descriptor, got error: %s", err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(&resp.Diagnostics, data.Tag.ValueString(), descriptor)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDescriptorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityDescriptorResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upsert(ctx, &data, &resp.Diagnostics, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityDescriptorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityDescriptorResourceModel()
+	state := NewCatalogEntityDescriptorResourceModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.upsert(ctx, &data, &resp.Diagnostics, "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// A descriptor that was unknown when planning may have changed the tag without replacing the resource, so remove
+	// the entity under the old tag.
+	if data.Tag.ValueString() != state.Tag.ValueString() {
+		err := r.client.CatalogEntities().Delete(ctx, state.Tag.ValueString())
+		if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity %s, got error: %s", state.Tag.ValueString(), err))
+			return
+		}
+	}
+}
+
+// upsert posts the planned descriptor and sets data from the descriptor stored by Cortex.
+func (r *CatalogEntityDescriptorResource) upsert(ctx context.Context, data *CatalogEntityDescriptorResourceModel, diagnostics *diag.Diagnostics, action string) {
+	tag, err := data.Descriptor.Tag()
+	if err != nil {
+		diagnostics.AddError("Invalid Catalog Entity Descriptor", err.Error())
+		return
+	}
+
+	descriptor, err := r.client.CatalogEntities().UpsertDescriptor(ctx, data.Descriptor.ValueString())
+	if err != nil {
+		diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to %s catalog entity descriptor, got error: %s", action, err))
+		return
+	}
+
+	data.FromApiModel(diagnostics, tag, descriptor)
+}
+
+func (r *CatalogEntityDescriptorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityDescriptorResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CatalogEntities().Delete(ctx, data.Tag.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity, got error: %s", err))
+		return
+	}
+}
+
+func (r *CatalogEntityDescriptorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityDescriptorResourceModel describes a catalog entity managed through its raw OpenAPI descriptor.
+type CatalogEntityDescriptorResourceModel struct {
+	Id         types.String    `tfsdk:"id"`
+	Tag        types.String    `tfsdk:"tag"`
+	Descriptor DescriptorValue `tfsdk:"descriptor"`
+}
+
+// FromApiModel sets the descriptor to the one stored by Cortex.
+func (r *CatalogEntityDescriptorResourceModel) FromApiModel(diagnostics *diag.Diagnostics, tag string, descriptor map[string]interface{}) {
+	r.Id = types.StringValue(tag)
+	r.Tag = types.StringValue(tag)
+
+	value, err := NewDescriptorValueFromApiModel(descriptor)
+	if err != nil {
+		diagnostics.AddError("Error encoding descriptor", err.Error())
+		return
+	}
+	r.Descriptor = value
+}
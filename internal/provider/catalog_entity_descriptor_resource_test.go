@@ -0,0 +1,66 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCatalogEntityDescriptorResource(t *testing.T) {
+	resourceName := "cortex_catalog_entity_descriptor.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityDescriptorResourceConfig("A service managed through its descriptor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "test-descriptor"),
+					resource.TestCheckResourceAttr(resourceName, "tag", "test-descriptor"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity.test", "description", "A service managed through its descriptor"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "test-descriptor",
+				ImportStateVerify: true,
+				// The imported descriptor is formatted by the API rather than as configured.
+				ImportStateVerifyIgnore: []string{"descriptor"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityDescriptorResourceConfig("An updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", "test-descriptor"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity.test", "description", "An updated description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityDescriptorResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity_descriptor" "test" {
+  descriptor = yamlencode({
+    openapi = "3.0.1"
+    info = {
+      title           = "Descriptor Service"
+      description     = %[1]q
+      x-cortex-tag    = "test-descriptor"
+      x-cortex-type   = "service"
+      x-cortex-groups = ["terraform"]
+    }
+  })
+}
+
+data "cortex_catalog_entity" "test" {
+  tag        = cortex_catalog_entity_descriptor.test.tag
+  depends_on = [cortex_catalog_entity_descriptor.test]
+}
+`, description)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogEntityPackagesDataSource{}
+
+func NewCatalogEntityPackagesDataSource() datasource.DataSource {
+	return &CatalogEntityPackagesDataSource{}
+}
+
+// CatalogEntityPackagesDataSource defines the data source implementation.
+type CatalogEntityPackagesDataSource struct {
+	client *cortex.HttpClient
+}
+
+func (d *CatalogEntityPackagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_packages"
+}
+
+func (d *CatalogEntityPackagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Catalog Entity Packages data source. Lists every package on a catalog entity, whether it was set through the API or found by Cortex.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the catalog entity",
+				Required:            true,
+			},
+
+			// Optional
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return packages of this type. One of `" + strings.Join(cortex.PackageTypes, "`, `") + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cortex.PackageTypes...),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"packages": schema.ListNestedAttribute{
+				MarkdownDescription: "Packages on the entity.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "ID of the package.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the package.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the package.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version of the package.",
+							Computed:            true,
+						},
+						"date_created": schema.StringAttribute{
+							MarkdownDescription: "When the package was added to the entity.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CatalogEntityPackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CatalogEntityPackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogEntityPackagesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packages, err := d.client.Packages().List(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list catalog entity packages, got error: %s", err))
+		return
+	}
+	data.FromApiModel(packages)
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
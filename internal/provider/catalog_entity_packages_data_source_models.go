@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// CatalogEntityPackagesDataSourceModel describes the data source data model.
+type CatalogEntityPackagesDataSourceModel struct {
+	Id       types.String                          `tfsdk:"id"`
+	Tag      types.String                          `tfsdk:"tag"`
+	Type     types.String                          `tfsdk:"type"`
+	Packages []CatalogEntityPackageDataSourceModel `tfsdk:"packages"`
+}
+
+type CatalogEntityPackageDataSourceModel struct {
+	Id          types.Int64  `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Name        types.String `tfsdk:"name"`
+	Version     types.String `tfsdk:"version"`
+	DateCreated types.String `tfsdk:"date_created"`
+}
+
+// FromApiModel sets Packages to the packages of the type being filtered on, or to every package without a filter.
+func (o *CatalogEntityPackagesDataSourceModel) FromApiModel(packages []cortex.Package) {
+	o.Id = o.Tag
+	o.Packages = make([]CatalogEntityPackageDataSourceModel, 0, len(packages))
+	for _, pkg := range packages {
+		if !o.Type.IsNull() && !strings.EqualFold(o.Type.ValueString(), pkg.PackageType) {
+			continue
+		}
+		o.Packages = append(o.Packages, CatalogEntityPackageDataSourceModel{
+			Id:          types.Int64Value(pkg.ID),
+			Type:        types.StringValue(strings.ToUpper(pkg.PackageType)),
+			Name:        types.StringValue(pkg.Name),
+			Version:     types.StringValue(pkg.Version),
+			DateCreated: types.StringValue(pkg.DateCreated),
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package provider_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCatalogEntityPackagesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccCatalogEntityPackagesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.all", "tag", "manual-test"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.all", "packages.#", "2"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.python", "packages.#", "1"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.python", "packages.0.type", "PYTHON"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.python", "packages.0.name", "requests"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.python", "packages.0.version", "2.31.0"),
+					resource.TestCheckResourceAttrSet("data.cortex_catalog_entity_packages.python", "packages.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCatalogEntityPackagesDataSourceConfig = `
+data "cortex_catalog_entity_packages" "all" {
+  tag = "manual-test"
+}
+
+data "cortex_catalog_entity_packages" "python" {
+  tag  = "manual-test"
+  type = "PYTHON"
+}
+`
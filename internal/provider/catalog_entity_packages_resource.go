@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CatalogEntityPackagesResource{}
+var _ resource.ResourceWithImportState = &CatalogEntityPackagesResource{}
+
+func NewCatalogEntityPackagesResource() resource.Resource {
+	return &CatalogEntityPackagesResource{}
+}
+
+func NewCatalogEntityPackagesResourceModel() CatalogEntityPackagesResourceModel {
+	return CatalogEntityPackagesResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// CatalogEntityPackagesResource manages packages on a catalog entity, leaving the packages that are not in its
+// configuration, such as the ones Cortex finds in the entity's repository.
+type CatalogEntityPackagesResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityPackagesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_entity_packages"
+}
+
+func (r *CatalogEntityPackagesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Language packages on a catalog entity, such as for repositories that Cortex cannot scan. " +
+			"Only the packages in `packages` are managed, so packages found by Cortex or set elsewhere are left as they are. " +
+			"Only Java packages can be managed, since Cortex only sets the other types of package from uploaded manifests. " +
+			"Imported resources manage no packages until they are next applied.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "The Catalog Entity tag for these packages.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"packages": schema.SetNestedAttribute{
+				MarkdownDescription: "Packages on the catalog entity. Each package can only be listed once per type.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the package. One of `" + strings.Join(cortex.SinglePackageTypes, "`, `") + "`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(cortex.SinglePackageTypes...),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the package, such as `com.example:billing-client`.",
+							Required:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version of the package.",
+							Required:            true,
+						},
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *CatalogEntityPackagesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CatalogEntityPackagesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewCatalogEntityPackagesResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	packages, err := r.client.Packages().List(ctx, data.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity packages, got error: %s", err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(data.Tag.ValueString(), packages)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityPackagesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewCatalogEntityPackagesResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CatalogEntityPackagesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewCatalogEntityPackagesResourceModel()
+	state := NewCatalogEntityPackagesResourceModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, state.Packages, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// apply deletes the packages in current that are not in data, and upserts the packages in data that are new or have a
+// different version than in current.
+func (r *CatalogEntityPackagesResource) apply(ctx context.Context, data *CatalogEntityPackagesResourceModel, current []CatalogEntityPackageResourceModel, diagnostics *diag.Diagnostics) {
+	tag := data.Tag.ValueString()
+
+	planned := make(map[string]CatalogEntityPackageResourceModel, len(data.Packages))
+	for _, pkg := range data.Packages {
+		if _, ok := planned[pkg.Key()]; ok {
+			diagnostics.AddError("Duplicate Package", fmt.Sprintf("Package %s of type %s is listed more than once.", pkg.Name.ValueString(), pkg.Type.ValueString()))
+			return
+		}
+		planned[pkg.Key()] = pkg
+	}
+
+	existing := make(map[string]CatalogEntityPackageResourceModel, len(current))
+	for _, pkg := range current {
+		existing[pkg.Key()] = pkg
+		if _, ok := planned[pkg.Key()]; ok {
+			continue
+		}
+		err := r.client.Packages().Delete(ctx, tag, pkg.Type.ValueString(), pkg.Name.ValueString())
+		if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity package %s, got error: %s", pkg.Name.ValueString(), err))
+			return
+		}
+	}
+
+	for key, pkg := range planned {
+		if prior, ok := existing[key]; ok && prior.Version.Equal(pkg.Version) {
+			continue
+		}
+		if _, err := r.client.Packages().Upsert(ctx, tag, pkg.ToUpsertRequest()); err != nil {
+			diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to upsert catalog entity package %s, got error: %s", pkg.Name.ValueString(), err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(tag)
+}
+
+func (r *CatalogEntityPackagesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewCatalogEntityPackagesResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, pkg := range data.Packages {
+		err := r.client.Packages().Delete(ctx, data.Tag.ValueString(), pkg.Type.ValueString(), pkg.Name.ValueString())
+		if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity package %s, got error: %s", pkg.Name.ValueString(), err))
+			return
+		}
+	}
+}
+
+func (r *CatalogEntityPackagesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
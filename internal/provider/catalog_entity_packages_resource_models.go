@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// CatalogEntityPackagesResourceModel describes the packages managed on one catalog entity.
+type CatalogEntityPackagesResourceModel struct {
+	Id       types.String                        `tfsdk:"id"`
+	Tag      types.String                        `tfsdk:"tag"`
+	Packages []CatalogEntityPackageResourceModel `tfsdk:"packages"`
+}
+
+// FromApiModel refreshes the version of each managed package from packages, dropping the ones that no longer exist on
+// the entity. Imported resources have no managed packages, since the entity's packages may have been found by Cortex,
+// so their packages are filled in by the next apply.
+func (r *CatalogEntityPackagesResourceModel) FromApiModel(tag string, packages []cortex.Package) {
+	r.Id = types.StringValue(tag)
+	r.Tag = types.StringValue(tag)
+
+	refreshed := make([]CatalogEntityPackageResourceModel, 0, len(r.Packages))
+	for _, managed := range r.Packages {
+		for _, pkg := range packages {
+			if managed.Matches(pkg) {
+				managed.Version = types.StringValue(pkg.Version)
+				refreshed = append(refreshed, managed)
+				break
+			}
+		}
+	}
+	r.Packages = refreshed
+}
+
+/***********************************************************************************************************************
+ * Packages
+ **********************************************************************************************************************/
+
+type CatalogEntityPackageResourceModel struct {
+	Type    types.String `tfsdk:"type"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+// Key identifies a package on an entity, since an entity has at most one version of each package.
+func (o *CatalogEntityPackageResourceModel) Key() string {
+	return strings.ToUpper(o.Type.ValueString()) + "/" + o.Name.ValueString()
+}
+
+// Matches reports whether pkg is this package, whatever its version.
+func (o *CatalogEntityPackageResourceModel) Matches(pkg cortex.Package) bool {
+	return strings.EqualFold(o.Type.ValueString(), pkg.PackageType) && o.Name.ValueString() == pkg.Name
+}
+
+func (o *CatalogEntityPackageResourceModel) ToUpsertRequest() cortex.UpsertPackageRequest {
+	return cortex.UpsertPackageRequest{
+		PackageType: o.Type.ValueString(),
+		Name:        o.Name.ValueString(),
+		Version:     o.Version.ValueString(),
+	}
+}
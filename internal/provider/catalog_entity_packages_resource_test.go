@@ -0,0 +1,95 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCatalogEntityPackagesResource(t *testing.T) {
+	resourceName := "cortex_catalog_entity_packages.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityPackagesResourceConfig(`
+    {
+      type    = "JAVA"
+      name    = "com.example:billing-client"
+      version = "1.8.3"
+    },
+    {
+      type    = "JAVA"
+      name    = "com.example:logging"
+      version = "2.0.0"
+    },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "test-packages"),
+					resource.TestCheckResourceAttr(resourceName, "packages.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "packages.*", map[string]string{
+						"type":    "JAVA",
+						"name":    "com.example:billing-client",
+						"version": "1.8.3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "packages.*", map[string]string{
+						"type":    "JAVA",
+						"name":    "com.example:logging",
+						"version": "2.0.0",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "test-packages",
+				ImportStateVerify: true,
+				// Imported resources do not manage any packages until they are next applied.
+				ImportStateVerifyIgnore: []string{"packages"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityPackagesResourceConfig(`
+    {
+      type    = "JAVA"
+      name    = "com.example:billing-client"
+      version = "1.9.0"
+    },
+`) + `
+data "cortex_catalog_entity_packages" "test" {
+  tag        = "test-packages"
+  depends_on = [cortex_catalog_entity_packages.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "packages.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "packages.*", map[string]string{
+						"type":    "JAVA",
+						"name":    "com.example:billing-client",
+						"version": "1.9.0",
+					}),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.test", "packages.#", "1"),
+					resource.TestCheckResourceAttr("data.cortex_catalog_entity_packages.test", "packages.0.version", "1.9.0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityPackagesResourceConfig(packages string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test" {
+  tag  = "test-packages"
+  name = "Packages service"
+}
+
+resource "cortex_catalog_entity_packages" "test" {
+  tag      = cortex_catalog_entity.test.tag
+  packages = [%s  ]
+}
+`, packages)
+}
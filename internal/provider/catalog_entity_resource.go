@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
@@ -79,6 +80,7 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 			"definition": schema.StringAttribute{
 				MarkdownDescription: "Set when the entity is a Resource. These are the properties defined by the Resource Definition, in JSON format in a string (use the `jsonencode` function to convert a JSON object to a string).",
 				Optional:            true,
+				CustomType:          JSONValueType{},
 			},
 
 			// Optional attributes
@@ -148,6 +150,42 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"relationships": schema.ListNestedAttribute{
+				MarkdownDescription: "List of custom relationships for the entity, such as the clusters a service runs on. Relationship types are managed with the `cortex_relationship_type` resource.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Tag of the relationship type.",
+							Required:            true,
+						},
+						"destinations": schema.ListNestedAttribute{
+							MarkdownDescription: "List of entities the entity is a source of through the relationship.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"tag": schema.StringAttribute{
+										MarkdownDescription: "Tag of the destination entity.",
+										Required:            true,
+									},
+								},
+							},
+						},
+						"sources": schema.ListNestedAttribute{
+							MarkdownDescription: "List of entities the entity is a destination of through the relationship.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"tag": schema.StringAttribute{
+										MarkdownDescription: "Tag of the source entity.",
+										Required:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"groups": schema.ListAttribute{
 				MarkdownDescription: "List of groups related to the entity.",
 				Optional:            true,
@@ -183,6 +221,14 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 			"metadata": schema.StringAttribute{
 				MarkdownDescription: "Custom metadata for the entity, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
 				Optional:            true,
+				CustomType:          JSONValueType{},
+			},
+			"api_spec": schema.StringAttribute{
+				MarkdownDescription: "OpenAPI or AsyncAPI spec of the entity in YAML or JSON, such as from the `file` or `yamlencode` functions. " +
+					"It is merged into the descriptor of the entity, whose `info` block takes precedence over the one of the spec, and `openapi` defaults to `" + cortex.DefaultOpenApiVersion + "` unless the spec sets `asyncapi`. " +
+					"Formatting, comments, key order and the `title` and `description` of the spec's `info` block are ignored when comparing it with the spec stored in Cortex.",
+				Optional:   true,
+				CustomType: ApiSpecValueType{},
 			},
 			"dependencies": schema.ListNestedAttribute{
 				MarkdownDescription: "List of dependencies for the entity.",
@@ -208,6 +254,7 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 						"metadata": schema.StringAttribute{
 							MarkdownDescription: "Custom metadata for the dependency, in JSON format in a string. (Use the `jsonencode` function to convert a JSON object to a string.)",
 							Optional:            true,
+							CustomType:          JSONValueType{},
 						},
 					},
 				},
@@ -850,6 +897,106 @@ func (r *CatalogEntityResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 				},
 			},
+			"infra": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud infrastructure resources of the entity, in AWS, Google Cloud and Azure.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						MarkdownDescription: "AWS resources of the entity.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"cloud_control": schema.ListNestedAttribute{
+								MarkdownDescription: "List of AWS Cloud Control resources of the entity.",
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"type": schema.StringAttribute{
+											MarkdownDescription: "Cloud Control resource type, such as `AWS::RDS::DBInstance`.",
+											Required:            true,
+										},
+										"region": schema.StringAttribute{
+											MarkdownDescription: "AWS region of the resource, such as `us-west-2`.",
+											Required:            true,
+										},
+										"account_id": schema.StringAttribute{
+											MarkdownDescription: "AWS account ID of the resource.",
+											Required:            true,
+										},
+										"identifier": schema.StringAttribute{
+											MarkdownDescription: "Cloud Control identifier of the resource.",
+											Required:            true,
+										},
+									},
+								},
+							},
+							"ecs": schema.ListNestedAttribute{
+								MarkdownDescription: "List of AWS ECS services of the entity.",
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"cluster_arn": schema.StringAttribute{
+											MarkdownDescription: "ARN of the ECS cluster running the service.",
+											Required:            true,
+										},
+										"service_arn": schema.StringAttribute{
+											MarkdownDescription: "ARN of the ECS service.",
+											Required:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						MarkdownDescription: "Google Cloud resources of the entity.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"resources": schema.ListNestedAttribute{
+								MarkdownDescription: "List of Google Cloud resources of the entity.",
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"resource_name": schema.StringAttribute{
+											MarkdownDescription: "Name of the resource, such as `us-central1/my-function`.",
+											Required:            true,
+										},
+										"project_id": schema.StringAttribute{
+											MarkdownDescription: "Google Cloud project ID of the resource.",
+											Required:            true,
+										},
+										"resource_type": schema.StringAttribute{
+											MarkdownDescription: "Type of the resource, such as `function`.",
+											Required:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+					"azure": schema.SingleNestedAttribute{
+						MarkdownDescription: "Azure resources of the entity.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"resources": schema.ListNestedAttribute{
+								MarkdownDescription: "List of Azure resources or resource groups of the entity.",
+								Optional:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											MarkdownDescription: "Azure resource ID, such as `/subscriptions/<subscription>/resourceGroups/<group>`.",
+											Required:            true,
+										},
+										"alias": schema.StringAttribute{
+											MarkdownDescription: "Optional. Alias of the Azure configuration to use.",
+											Optional:            true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"k8s": schema.SingleNestedAttribute{
 				MarkdownDescription: "Kubernetes configuration for the entity.",
 				Optional:            true,
@@ -1195,7 +1342,7 @@ func (r *CatalogEntityResource) Create(ctx context.Context, req resource.CreateR
 	// Issue API request
 	entity, err := r.client.CatalogEntities().Upsert(ctx, upsertRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
 		return
 	}
 
@@ -1227,7 +1374,12 @@ func (r *CatalogEntityResource) Read(ctx context.Context, req resource.ReadReque
 	entity, err := r.client.CatalogEntities().GetFromDescriptor(ctx, data.Tag.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			// The resource was deleted outside of Terraform; drop it from state so it gets re-created.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
 		return
 	}
 
@@ -1264,7 +1416,7 @@ func (r *CatalogEntityResource) Update(ctx context.Context, req resource.UpdateR
 	// Issue API request to Cortex
 	entity, err := r.client.CatalogEntities().Upsert(ctx, upsertRequest)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read catalog entity, got error: %s", err))
 		return
 	}
 
@@ -1297,7 +1449,7 @@ func (r *CatalogEntityResource) Delete(ctx context.Context, req resource.DeleteR
 
 	err := r.client.CatalogEntities().Delete(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entity, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete catalog entity, got error: %s", err))
 		return
 	}
 }
@@ -16,44 +16,47 @@ import (
 
 // CatalogEntityResourceModel describes the resource data model.
 type CatalogEntityResourceModel struct {
-	Id             types.String                       `tfsdk:"id"`
-	Tag            types.String                       `tfsdk:"tag"`
-	Name           types.String                       `tfsdk:"name"`
-	Description    types.String                       `tfsdk:"description"`
-	Type           types.String                       `tfsdk:"type"`
-	Definition     types.String                       `tfsdk:"definition"`
-	Owners         []CatalogEntityOwnerResourceModel  `tfsdk:"owners"`
-	Children       []CatalogEntityChildResourceModel  `tfsdk:"children"`
-	Parents        []CatalogEntityParentResourceModel `tfsdk:"parents"`
-	Groups         []types.String                     `tfsdk:"groups"`
-	Links          []CatalogEntityLinkResourceModel   `tfsdk:"links"`
-	IgnoreMetadata types.Bool                         `tfsdk:"ignore_metadata"`
-	Metadata       types.String                       `tfsdk:"metadata"`
-	Dependencies   []types.Object                     `tfsdk:"dependencies"`
-	Alerts         []types.Object                     `tfsdk:"alerts"`
-	Apm            types.Object                       `tfsdk:"apm"`
-	Dashboards     types.Object                       `tfsdk:"dashboards"`
-	Git            types.Object                       `tfsdk:"git"`
-	Issues         types.Object                       `tfsdk:"issues"`
-	OnCall         types.Object                       `tfsdk:"on_call"`
-	SLOs           types.Object                       `tfsdk:"slos"`
-	StaticAnalysis types.Object                       `tfsdk:"static_analysis"`
-	CiCd           types.Object                       `tfsdk:"ci_cd"`
-	BugSnag        types.Object                       `tfsdk:"bug_snag"`
-	Checkmarx      types.Object                       `tfsdk:"checkmarx"`
-	CircleCi       types.Object                       `tfsdk:"circle_ci"`
-	Coralogix      types.Object                       `tfsdk:"coralogix"`
-	FireHydrant    types.Object                       `tfsdk:"firehydrant"`
-	K8s            types.Object                       `tfsdk:"k8s"`
-	LaunchDarkly   types.Object                       `tfsdk:"launch_darkly"`
-	MicrosoftTeams []types.Object                     `tfsdk:"microsoft_teams"`
-	Rollbar        types.Object                       `tfsdk:"rollbar"`
-	Sentry         types.Object                       `tfsdk:"sentry"`
-	ServiceNow     types.Object                       `tfsdk:"service_now"`
-	Slack          types.Object                       `tfsdk:"slack"`
-	Snyk           types.Object                       `tfsdk:"snyk"`
-	Wiz            types.Object                       `tfsdk:"wiz"`
-	Team           types.Object                       `tfsdk:"team"`
+	Id             types.String                             `tfsdk:"id"`
+	Tag            types.String                             `tfsdk:"tag"`
+	Name           types.String                             `tfsdk:"name"`
+	Description    types.String                             `tfsdk:"description"`
+	Type           types.String                             `tfsdk:"type"`
+	Definition     JSONValue                                `tfsdk:"definition"`
+	Owners         []CatalogEntityOwnerResourceModel        `tfsdk:"owners"`
+	Children       []CatalogEntityChildResourceModel        `tfsdk:"children"`
+	Parents        []CatalogEntityParentResourceModel       `tfsdk:"parents"`
+	Relationships  []CatalogEntityRelationshipResourceModel `tfsdk:"relationships"`
+	Groups         []types.String                           `tfsdk:"groups"`
+	Links          []CatalogEntityLinkResourceModel         `tfsdk:"links"`
+	IgnoreMetadata types.Bool                               `tfsdk:"ignore_metadata"`
+	Metadata       JSONValue                                `tfsdk:"metadata"`
+	ApiSpec        ApiSpecValue                             `tfsdk:"api_spec"`
+	Dependencies   []types.Object                           `tfsdk:"dependencies"`
+	Alerts         []types.Object                           `tfsdk:"alerts"`
+	Apm            types.Object                             `tfsdk:"apm"`
+	Dashboards     types.Object                             `tfsdk:"dashboards"`
+	Git            types.Object                             `tfsdk:"git"`
+	Issues         types.Object                             `tfsdk:"issues"`
+	OnCall         types.Object                             `tfsdk:"on_call"`
+	SLOs           types.Object                             `tfsdk:"slos"`
+	StaticAnalysis types.Object                             `tfsdk:"static_analysis"`
+	CiCd           types.Object                             `tfsdk:"ci_cd"`
+	BugSnag        types.Object                             `tfsdk:"bug_snag"`
+	Checkmarx      types.Object                             `tfsdk:"checkmarx"`
+	CircleCi       types.Object                             `tfsdk:"circle_ci"`
+	Coralogix      types.Object                             `tfsdk:"coralogix"`
+	FireHydrant    types.Object                             `tfsdk:"firehydrant"`
+	Infra          types.Object                             `tfsdk:"infra"`
+	K8s            types.Object                             `tfsdk:"k8s"`
+	LaunchDarkly   types.Object                             `tfsdk:"launch_darkly"`
+	MicrosoftTeams []types.Object                           `tfsdk:"microsoft_teams"`
+	Rollbar        types.Object                             `tfsdk:"rollbar"`
+	Sentry         types.Object                             `tfsdk:"sentry"`
+	ServiceNow     types.Object                             `tfsdk:"service_now"`
+	Slack          types.Object                             `tfsdk:"slack"`
+	Snyk           types.Object                             `tfsdk:"snyk"`
+	Wiz            types.Object                             `tfsdk:"wiz"`
+	Team           types.Object                             `tfsdk:"team"`
 }
 
 func getDefaultObjectOptions() basetypes.ObjectAsOptions {
@@ -85,6 +88,10 @@ func (o *CatalogEntityResourceModel) ToApiModel(ctx context.Context, diagnostics
 	for i, parent := range o.Parents {
 		Parents[i] = parent.ToApiModel()
 	}
+	relationships := make([]cortex.CatalogEntityRelationship, len(o.Relationships))
+	for i, relationship := range o.Relationships {
+		relationships[i] = relationship.ToApiModel()
+	}
 	groups := make([]string, len(o.Groups))
 	for i, group := range o.Groups {
 		groups[i] = group.ValueString()
@@ -114,6 +121,14 @@ func (o *CatalogEntityResourceModel) ToApiModel(ctx context.Context, diagnostics
 	} else {
 		metadata = make(map[string]interface{})
 	}
+	var apiSpec map[string]interface{}
+	if !o.ApiSpec.IsNull() && !o.ApiSpec.IsUnknown() {
+		spec, err := o.ApiSpec.Spec()
+		if err != nil {
+			diagnostics.AddError("error parsing API spec", fmt.Sprintf("%+v", err))
+		}
+		apiSpec = spec
+	}
 	dependencies := make([]cortex.CatalogEntityDependency, len(o.Dependencies))
 	for i, dependency := range o.Dependencies {
 		dep := CatalogEntityDependencyResourceModel{}
@@ -197,6 +212,11 @@ func (o *CatalogEntityResourceModel) ToApiModel(ctx context.Context, diagnostics
 	if err != nil {
 		diagnostics.AddError("error parsing FireHydrant configuration", fmt.Sprintf("%+v", err))
 	}
+	infra := CatalogEntityInfraResourceModel{}
+	err = o.Infra.As(ctx, &infra, defaultObjOptions)
+	if err != nil {
+		diagnostics.AddError("error parsing Infra configuration", fmt.Sprintf("%+v", err))
+	}
 	k8s := CatalogEntityK8sResourceModel{}
 	err = o.K8s.As(ctx, &k8s, defaultObjOptions)
 	if err != nil {
@@ -261,10 +281,12 @@ func (o *CatalogEntityResourceModel) ToApiModel(ctx context.Context, diagnostics
 		Owners:         owners,
 		Children:       children,
 		Parents:        Parents,
+		Relationships:  relationships,
 		Groups:         groups,
 		Links:          links,
 		IgnoreMetadata: o.IgnoreMetadata.ValueBool(),
 		Metadata:       metadata,
+		ApiSpec:        apiSpec,
 		Dependencies:   dependencies,
 		Alerts:         alerts,
 		Dashboards:     dashboards.ToApiModel(),
@@ -280,6 +302,8 @@ func (o *CatalogEntityResourceModel) ToApiModel(ctx context.Context, diagnostics
 		CircleCi:       circleci.ToApiModel(),
 		Coralogix:      coralogix.ToApiModel(),
 		FireHydrant:    firehydrant.ToApiModel(),
+		Infra:          infra.ToApiModel(ctx),
+		Azure:          infra.ToAzureApiModel(ctx),
 		K8s:            k8s.ToApiModel(),
 		LaunchDarkly:   launchDarkly.ToApiModel(),
 		MicrosoftTeams: microsoftTeams,
@@ -314,9 +338,9 @@ func (o *CatalogEntityResourceModel) FromApiModel(ctx context.Context, diagnosti
 			diagnostics.AddError("Error parsing definition: %s", err.Error())
 			return
 		}
-		o.Definition = types.StringValue(string(definition))
+		o.Definition = NewJSONValue(string(definition))
 	} else {
-		o.Definition = types.StringNull()
+		o.Definition = NewJSONNull()
 	}
 
 	if len(entity.Owners) > 0 {
@@ -349,6 +373,16 @@ func (o *CatalogEntityResourceModel) FromApiModel(ctx context.Context, diagnosti
 		o.Parents = nil
 	}
 
+	if len(entity.Relationships) > 0 {
+		o.Relationships = make([]CatalogEntityRelationshipResourceModel, len(entity.Relationships))
+		for i, relationship := range entity.Relationships {
+			m := CatalogEntityRelationshipResourceModel{}
+			o.Relationships[i] = m.FromApiModel(&relationship)
+		}
+	} else {
+		o.Relationships = nil
+	}
+
 	if len(entity.Groups) > 0 {
 		o.Groups = make([]types.String, len(entity.Groups))
 		for i, group := range entity.Groups {
@@ -375,10 +409,17 @@ func (o *CatalogEntityResourceModel) FromApiModel(ctx context.Context, diagnosti
 			diagnostics.AddError("Error parsing metadata: %s", err.Error())
 			return
 		}
-		o.Metadata = types.StringValue(string(metadata))
+		o.Metadata = NewJSONValue(string(metadata))
 	} else {
-		o.Metadata = types.StringNull()
+		o.Metadata = NewJSONNull()
+	}
+
+	apiSpec, err := NewApiSpecValueFromApiModel(entity.ApiSpec)
+	if err != nil {
+		diagnostics.AddError("Error parsing API spec", err.Error())
+		return
 	}
+	o.ApiSpec = apiSpec
 
 	if len(entity.Dependencies) > 0 {
 		o.Dependencies = make([]types.Object, len(entity.Dependencies))
@@ -436,6 +477,9 @@ func (o *CatalogEntityResourceModel) FromApiModel(ctx context.Context, diagnosti
 	firehydrant := CatalogEntityFireHydrantResourceModel{}
 	o.FireHydrant = firehydrant.FromApiModel(ctx, diagnostics, &entity.FireHydrant)
 
+	infra := CatalogEntityInfraResourceModel{}
+	o.Infra = infra.FromApiModel(ctx, diagnostics, &entity.Infra, &entity.Azure)
+
 	k8s := CatalogEntityK8sResourceModel{}
 	o.K8s = k8s.FromApiModel(ctx, diagnostics, &entity.K8s)
 
@@ -597,6 +641,66 @@ func (o *CatalogEntityParentResourceModel) FromApiModel(entity *cortex.CatalogEn
 	}
 }
 
+/***********************************************************************************************************************
+ * Relationships
+ ***********************************************************************************************************************/
+
+// CatalogEntityRelationshipResourceModel describes the entities related to the catalog entity through a custom
+// relationship type.
+type CatalogEntityRelationshipResourceModel struct {
+	Type         types.String                                   `tfsdk:"type"`
+	Destinations []CatalogEntityRelationshipEntityResourceModel `tfsdk:"destinations"`
+	Sources      []CatalogEntityRelationshipEntityResourceModel `tfsdk:"sources"`
+}
+
+func (o *CatalogEntityRelationshipResourceModel) ToApiModel() cortex.CatalogEntityRelationship {
+	var destinations []cortex.CatalogEntityRelationshipEntity
+	for _, destination := range o.Destinations {
+		destinations = append(destinations, destination.ToApiModel())
+	}
+	var sources []cortex.CatalogEntityRelationshipEntity
+	for _, source := range o.Sources {
+		sources = append(sources, source.ToApiModel())
+	}
+	return cortex.CatalogEntityRelationship{
+		Type:         o.Type.ValueString(),
+		Destinations: destinations,
+		Sources:      sources,
+	}
+}
+
+func (o *CatalogEntityRelationshipResourceModel) FromApiModel(entity *cortex.CatalogEntityRelationship) CatalogEntityRelationshipResourceModel {
+	obj := CatalogEntityRelationshipResourceModel{
+		Type: types.StringValue(entity.Type),
+	}
+	for _, destination := range entity.Destinations {
+		m := CatalogEntityRelationshipEntityResourceModel{}
+		obj.Destinations = append(obj.Destinations, m.FromApiModel(&destination))
+	}
+	for _, source := range entity.Sources {
+		m := CatalogEntityRelationshipEntityResourceModel{}
+		obj.Sources = append(obj.Sources, m.FromApiModel(&source))
+	}
+	return obj
+}
+
+// CatalogEntityRelationshipEntityResourceModel describes an entity on the other end of a relationship.
+type CatalogEntityRelationshipEntityResourceModel struct {
+	Tag types.String `tfsdk:"tag"`
+}
+
+func (o *CatalogEntityRelationshipEntityResourceModel) ToApiModel() cortex.CatalogEntityRelationshipEntity {
+	return cortex.CatalogEntityRelationshipEntity{
+		Tag: o.Tag.ValueString(),
+	}
+}
+
+func (o *CatalogEntityRelationshipEntityResourceModel) FromApiModel(entity *cortex.CatalogEntityRelationshipEntity) CatalogEntityRelationshipEntityResourceModel {
+	return CatalogEntityRelationshipEntityResourceModel{
+		Tag: types.StringValue(entity.Tag),
+	}
+}
+
 /***********************************************************************************************************************
  * Links
  ***********************************************************************************************************************/
@@ -632,7 +736,7 @@ type CatalogEntityDependencyResourceModel struct {
 	Method      types.String `tfsdk:"method"`
 	Path        types.String `tfsdk:"path"`
 	Description types.String `tfsdk:"description"`
-	Metadata    types.String `tfsdk:"metadata"`
+	Metadata    JSONValue    `tfsdk:"metadata"`
 }
 
 func (o *CatalogEntityDependencyResourceModel) AttrTypes() map[string]attr.Type {
@@ -641,7 +745,7 @@ func (o *CatalogEntityDependencyResourceModel) AttrTypes() map[string]attr.Type
 		"method":      types.StringType,
 		"path":        types.StringType,
 		"description": types.StringType,
-		"metadata":    types.StringType,
+		"metadata":    JSONValueType{},
 	}
 }
 
@@ -694,9 +798,9 @@ func (o *CatalogEntityDependencyResourceModel) FromApiModel(ctx context.Context,
 			diagnostics.AddError("error marshalling dependency metadata", fmt.Sprintf("%+v", err))
 			depMetadata = []byte{}
 		}
-		obj.Metadata = types.StringValue(string(depMetadata))
+		obj.Metadata = NewJSONValue(string(depMetadata))
 	} else {
-		obj.Metadata = types.StringNull()
+		obj.Metadata = NewJSONNull()
 	}
 
 	retObj, d := types.ObjectValueFrom(ctx, obj.AttrTypes(), &obj)
@@ -1643,6 +1747,324 @@ func (o *CatalogEntityFireHydrantServiceResourceModel) FromApiModel(service cort
 	}
 }
 
+/***********************************************************************************************************************
+ * Infrastructure
+ **********************************************************************************************************************/
+
+type CatalogEntityInfraResourceModel struct {
+	Aws   types.Object `tfsdk:"aws"`
+	Gcp   types.Object `tfsdk:"gcp"`
+	Azure types.Object `tfsdk:"azure"`
+}
+
+func (o *CatalogEntityInfraResourceModel) AttrTypes() map[string]attr.Type {
+	aws := CatalogEntityInfraAwsResourceModel{}
+	gcp := CatalogEntityInfraGcpResourceModel{}
+	az := CatalogEntityInfraAzureResourceModel{}
+	return map[string]attr.Type{
+		"aws":   types.ObjectType{AttrTypes: aws.AttrTypes()},
+		"gcp":   types.ObjectType{AttrTypes: gcp.AttrTypes()},
+		"azure": types.ObjectType{AttrTypes: az.AttrTypes()},
+	}
+}
+
+func (o *CatalogEntityInfraResourceModel) ToApiModel(ctx context.Context) cortex.CatalogEntityInfra {
+	infra := cortex.CatalogEntityInfra{}
+	defaultObjOptions := getDefaultObjectOptions()
+
+	if !o.Aws.IsNull() {
+		om := CatalogEntityInfraAwsResourceModel{}
+		o.Aws.As(ctx, &om, defaultObjOptions)
+		infra.Aws = om.ToApiModel()
+	}
+	if !o.Gcp.IsNull() {
+		om := CatalogEntityInfraGcpResourceModel{}
+		o.Gcp.As(ctx, &om, defaultObjOptions)
+		infra.Gcp = om.ToApiModel()
+	}
+	return infra
+}
+
+// ToAzureApiModel returns the Azure resources, which Cortex stores under x-cortex-azure rather than x-cortex-infra.
+func (o *CatalogEntityInfraResourceModel) ToAzureApiModel(ctx context.Context) cortex.CatalogEntityAzure {
+	if o.Azure.IsNull() {
+		return cortex.CatalogEntityAzure{}
+	}
+	om := CatalogEntityInfraAzureResourceModel{}
+	o.Azure.As(ctx, &om, getDefaultObjectOptions())
+	return om.ToApiModel()
+}
+
+func (o *CatalogEntityInfraResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.CatalogEntityInfra, azure *cortex.CatalogEntityAzure) types.Object {
+	infra := CatalogEntityInfraResourceModel{}
+	if !entity.Enabled() && !azure.Enabled() {
+		return types.ObjectNull(infra.AttrTypes())
+	}
+
+	aws := CatalogEntityInfraAwsResourceModel{}
+	infra.Aws = aws.FromApiModel(ctx, diagnostics, &entity.Aws)
+
+	gcp := CatalogEntityInfraGcpResourceModel{}
+	infra.Gcp = gcp.FromApiModel(ctx, diagnostics, &entity.Gcp)
+
+	az := CatalogEntityInfraAzureResourceModel{}
+	infra.Azure = az.FromApiModel(ctx, diagnostics, azure)
+
+	obj, d := types.ObjectValueFrom(ctx, infra.AttrTypes(), &infra)
+	diagnostics.Append(d...)
+	return obj
+}
+
+// AWS
+
+type CatalogEntityInfraAwsResourceModel struct {
+	CloudControl []CatalogEntityInfraAwsCloudControlResourceModel `tfsdk:"cloud_control"`
+	Ecs          []CatalogEntityInfraAwsEcsResourceModel          `tfsdk:"ecs"`
+}
+
+func (o *CatalogEntityInfraAwsResourceModel) AttrTypes() map[string]attr.Type {
+	cc := CatalogEntityInfraAwsCloudControlResourceModel{}
+	ecs := CatalogEntityInfraAwsEcsResourceModel{}
+	return map[string]attr.Type{
+		"cloud_control": types.ListType{ElemType: types.ObjectType{AttrTypes: cc.AttrTypes()}},
+		"ecs":           types.ListType{ElemType: types.ObjectType{AttrTypes: ecs.AttrTypes()}},
+	}
+}
+
+func (o *CatalogEntityInfraAwsResourceModel) ToApiModel() cortex.CatalogEntityInfraAws {
+	cloudControl := make([]cortex.CatalogEntityInfraAwsCloudControl, len(o.CloudControl))
+	for i, c := range o.CloudControl {
+		cloudControl[i] = c.ToApiModel()
+	}
+	ecs := make([]cortex.CatalogEntityInfraAwsEcs, len(o.Ecs))
+	for i, c := range o.Ecs {
+		ecs[i] = c.ToApiModel()
+	}
+	return cortex.CatalogEntityInfraAws{
+		CloudControl: cloudControl,
+		Ecs:          ecs,
+	}
+}
+
+func (o *CatalogEntityInfraAwsResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.CatalogEntityInfraAws) types.Object {
+	ob := CatalogEntityInfraAwsResourceModel{}
+	if !entity.Enabled() {
+		return types.ObjectNull(ob.AttrTypes())
+	}
+
+	// Lists that are not set in Cortex are left nil, so that they are null rather than empty.
+	for _, e := range entity.CloudControl {
+		ch := CatalogEntityInfraAwsCloudControlResourceModel{}
+		ob.CloudControl = append(ob.CloudControl, ch.FromApiModel(&e))
+	}
+	for _, e := range entity.Ecs {
+		ch := CatalogEntityInfraAwsEcsResourceModel{}
+		ob.Ecs = append(ob.Ecs, ch.FromApiModel(&e))
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ob.AttrTypes(), &ob)
+	diagnostics.Append(d...)
+	return obj
+}
+
+type CatalogEntityInfraAwsCloudControlResourceModel struct {
+	Type       types.String `tfsdk:"type"`
+	Region     types.String `tfsdk:"region"`
+	AccountID  types.String `tfsdk:"account_id"`
+	Identifier types.String `tfsdk:"identifier"`
+}
+
+func (o *CatalogEntityInfraAwsCloudControlResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":       types.StringType,
+		"region":     types.StringType,
+		"account_id": types.StringType,
+		"identifier": types.StringType,
+	}
+}
+
+func (o *CatalogEntityInfraAwsCloudControlResourceModel) ToApiModel() cortex.CatalogEntityInfraAwsCloudControl {
+	return cortex.CatalogEntityInfraAwsCloudControl{
+		Type:       o.Type.ValueString(),
+		Region:     o.Region.ValueString(),
+		AccountID:  o.AccountID.ValueString(),
+		Identifier: o.Identifier.ValueString(),
+	}
+}
+
+func (o *CatalogEntityInfraAwsCloudControlResourceModel) FromApiModel(entity *cortex.CatalogEntityInfraAwsCloudControl) CatalogEntityInfraAwsCloudControlResourceModel {
+	return CatalogEntityInfraAwsCloudControlResourceModel{
+		Type:       types.StringValue(entity.Type),
+		Region:     types.StringValue(entity.Region),
+		AccountID:  types.StringValue(entity.AccountID),
+		Identifier: types.StringValue(entity.Identifier),
+	}
+}
+
+type CatalogEntityInfraAwsEcsResourceModel struct {
+	ClusterArn types.String `tfsdk:"cluster_arn"`
+	ServiceArn types.String `tfsdk:"service_arn"`
+}
+
+func (o *CatalogEntityInfraAwsEcsResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster_arn": types.StringType,
+		"service_arn": types.StringType,
+	}
+}
+
+func (o *CatalogEntityInfraAwsEcsResourceModel) ToApiModel() cortex.CatalogEntityInfraAwsEcs {
+	return cortex.CatalogEntityInfraAwsEcs{
+		ClusterArn: o.ClusterArn.ValueString(),
+		ServiceArn: o.ServiceArn.ValueString(),
+	}
+}
+
+func (o *CatalogEntityInfraAwsEcsResourceModel) FromApiModel(entity *cortex.CatalogEntityInfraAwsEcs) CatalogEntityInfraAwsEcsResourceModel {
+	return CatalogEntityInfraAwsEcsResourceModel{
+		ClusterArn: types.StringValue(entity.ClusterArn),
+		ServiceArn: types.StringValue(entity.ServiceArn),
+	}
+}
+
+// Google Cloud
+
+type CatalogEntityInfraGcpResourceModel struct {
+	Resources []CatalogEntityInfraGcpResourceResourceModel `tfsdk:"resources"`
+}
+
+func (o *CatalogEntityInfraGcpResourceModel) AttrTypes() map[string]attr.Type {
+	re := CatalogEntityInfraGcpResourceResourceModel{}
+	return map[string]attr.Type{
+		"resources": types.ListType{ElemType: types.ObjectType{AttrTypes: re.AttrTypes()}},
+	}
+}
+
+func (o *CatalogEntityInfraGcpResourceModel) ToApiModel() cortex.CatalogEntityInfraGcp {
+	resources := make([]cortex.CatalogEntityInfraGcpResource, len(o.Resources))
+	for i, c := range o.Resources {
+		resources[i] = c.ToApiModel()
+	}
+	return cortex.CatalogEntityInfraGcp{
+		Resources: resources,
+	}
+}
+
+func (o *CatalogEntityInfraGcpResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.CatalogEntityInfraGcp) types.Object {
+	ob := CatalogEntityInfraGcpResourceModel{}
+	if !entity.Enabled() {
+		return types.ObjectNull(ob.AttrTypes())
+	}
+
+	for _, e := range entity.Resources {
+		ch := CatalogEntityInfraGcpResourceResourceModel{}
+		ob.Resources = append(ob.Resources, ch.FromApiModel(&e))
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ob.AttrTypes(), &ob)
+	diagnostics.Append(d...)
+	return obj
+}
+
+type CatalogEntityInfraGcpResourceResourceModel struct {
+	ResourceName types.String `tfsdk:"resource_name"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+func (o *CatalogEntityInfraGcpResourceResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"resource_name": types.StringType,
+		"project_id":    types.StringType,
+		"resource_type": types.StringType,
+	}
+}
+
+func (o *CatalogEntityInfraGcpResourceResourceModel) ToApiModel() cortex.CatalogEntityInfraGcpResource {
+	return cortex.CatalogEntityInfraGcpResource{
+		ResourceName: o.ResourceName.ValueString(),
+		ProjectID:    o.ProjectID.ValueString(),
+		ResourceType: o.ResourceType.ValueString(),
+	}
+}
+
+func (o *CatalogEntityInfraGcpResourceResourceModel) FromApiModel(entity *cortex.CatalogEntityInfraGcpResource) CatalogEntityInfraGcpResourceResourceModel {
+	return CatalogEntityInfraGcpResourceResourceModel{
+		ResourceName: types.StringValue(entity.ResourceName),
+		ProjectID:    types.StringValue(entity.ProjectID),
+		ResourceType: types.StringValue(entity.ResourceType),
+	}
+}
+
+// Azure
+
+type CatalogEntityInfraAzureResourceModel struct {
+	Resources []CatalogEntityInfraAzureResourceResourceModel `tfsdk:"resources"`
+}
+
+func (o *CatalogEntityInfraAzureResourceModel) AttrTypes() map[string]attr.Type {
+	re := CatalogEntityInfraAzureResourceResourceModel{}
+	return map[string]attr.Type{
+		"resources": types.ListType{ElemType: types.ObjectType{AttrTypes: re.AttrTypes()}},
+	}
+}
+
+func (o *CatalogEntityInfraAzureResourceModel) ToApiModel() cortex.CatalogEntityAzure {
+	resources := make([]cortex.CatalogEntityAzureResource, len(o.Resources))
+	for i, c := range o.Resources {
+		resources[i] = c.ToApiModel()
+	}
+	return cortex.CatalogEntityAzure{
+		Resources: resources,
+	}
+}
+
+func (o *CatalogEntityInfraAzureResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.CatalogEntityAzure) types.Object {
+	ob := CatalogEntityInfraAzureResourceModel{}
+	if !entity.Enabled() {
+		return types.ObjectNull(ob.AttrTypes())
+	}
+
+	for _, e := range entity.Resources {
+		ch := CatalogEntityInfraAzureResourceResourceModel{}
+		ob.Resources = append(ob.Resources, ch.FromApiModel(&e))
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ob.AttrTypes(), &ob)
+	diagnostics.Append(d...)
+	return obj
+}
+
+type CatalogEntityInfraAzureResourceResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Alias types.String `tfsdk:"alias"`
+}
+
+func (o *CatalogEntityInfraAzureResourceResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":    types.StringType,
+		"alias": types.StringType,
+	}
+}
+
+func (o *CatalogEntityInfraAzureResourceResourceModel) ToApiModel() cortex.CatalogEntityAzureResource {
+	return cortex.CatalogEntityAzureResource{
+		ID:    o.ID.ValueString(),
+		Alias: o.Alias.ValueString(),
+	}
+}
+
+func (o *CatalogEntityInfraAzureResourceResourceModel) FromApiModel(entity *cortex.CatalogEntityAzureResource) CatalogEntityInfraAzureResourceResourceModel {
+	alias := types.StringValue(entity.Alias)
+	if entity.Alias == "" {
+		alias = types.StringNull()
+	}
+	return CatalogEntityInfraAzureResourceResourceModel{
+		ID:    types.StringValue(entity.ID),
+		Alias: alias,
+	}
+}
+
 /***********************************************************************************************************************
  * Kubernetes
  **********************************************************************************************************************/
@@ -675,3 +675,262 @@ resource "cortex_catalog_entity" "test-unmanaged-metadata" {
  })
 }`, tag, name, description)
 }
+
+func TestAccCatalogEntityResourceInfra(t *testing.T) {
+	tag := "test-infra"
+	resourceName := "cortex_catalog_entity.test-infra"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityResourceInfra(tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.cloud_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.cloud_control.0.type", "AWS::RDS::DBInstance"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.cloud_control.0.region", "us-west-2"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.cloud_control.0.account_id", "123456123456"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.cloud_control.0.identifier", "infra-db"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.ecs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.ecs.0.cluster_arn", "arn:aws:ecs:us-west-2:123456123456:cluster/infra"),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.ecs.0.service_arn", "arn:aws:ecs:us-west-2:123456123456:service/infra/infra-api"),
+					resource.TestCheckResourceAttr(resourceName, "infra.gcp.resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "infra.gcp.resources.0.resource_name", "us-central1/infra-function"),
+					resource.TestCheckResourceAttr(resourceName, "infra.gcp.resources.0.project_id", "infra-project"),
+					resource.TestCheckResourceAttr(resourceName, "infra.gcp.resources.0.resource_type", "function"),
+					resource.TestCheckResourceAttr(resourceName, "infra.azure.resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "infra.azure.resources.0.id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/infra"),
+					resource.TestCheckResourceAttr(resourceName, "infra.azure.resources.0.alias", "default"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityResourceInfraAwsOnly(tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttr(resourceName, "infra.aws.ecs.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "infra.aws.cloud_control"),
+					resource.TestCheckNoResourceAttr(resourceName, "infra.gcp"),
+					resource.TestCheckNoResourceAttr(resourceName, "infra.azure"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityResourceInfra(tag string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test-infra" {
+ tag = %[1]q
+ name = "Infra Test Service"
+ infra = {
+  aws = {
+   cloud_control = [
+    {
+     type = "AWS::RDS::DBInstance"
+     region = "us-west-2"
+     account_id = "123456123456"
+     identifier = "infra-db"
+    }
+   ]
+   ecs = [
+    {
+     cluster_arn = "arn:aws:ecs:us-west-2:123456123456:cluster/infra"
+     service_arn = "arn:aws:ecs:us-west-2:123456123456:service/infra/infra-api"
+    }
+   ]
+  }
+  gcp = {
+   resources = [
+    {
+     resource_name = "us-central1/infra-function"
+     project_id = "infra-project"
+     resource_type = "function"
+    }
+   ]
+  }
+  azure = {
+   resources = [
+    {
+     id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/infra"
+     alias = "default"
+    }
+   ]
+  }
+ }
+}`, tag)
+}
+
+func testAccCatalogEntityResourceInfraAwsOnly(tag string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test-infra" {
+ tag = %[1]q
+ name = "Infra Test Service"
+ infra = {
+  aws = {
+   ecs = [
+    {
+     cluster_arn = "arn:aws:ecs:us-west-2:123456123456:cluster/infra"
+     service_arn = "arn:aws:ecs:us-west-2:123456123456:service/infra/infra-api"
+    }
+   ]
+  }
+ }
+}`, tag)
+}
+
+func TestAccCatalogEntityResourceRelationships(t *testing.T) {
+	resourceName := "cortex_catalog_entity.test-relationships"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityResourceRelationships(`
+  {
+   type = cortex_relationship_type.runs-on.tag
+   destinations = [
+    { tag = "test-relationships-prod" },
+    { tag = "test-relationships-staging" },
+   ]
+  },
+  {
+   type = cortex_relationship_type.deploys-to.tag
+   sources = [
+    { tag = "test-relationships-pipeline" },
+   ]
+  },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "relationships.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.type", "test-relationships-runs-on"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.destinations.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.destinations.0.tag", "test-relationships-prod"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.destinations.1.tag", "test-relationships-staging"),
+					resource.TestCheckNoResourceAttr(resourceName, "relationships.0.sources"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.1.type", "test-relationships-deploys-to"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.1.sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.1.sources.0.tag", "test-relationships-pipeline"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityResourceRelationships(`
+  {
+   type = cortex_relationship_type.runs-on.tag
+   destinations = [
+    { tag = "test-relationships-prod" },
+   ]
+  },
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "relationships.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.0.destinations.0.tag", "test-relationships-prod"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityResourceRelationships(relationships string) string {
+	return fmt.Sprintf(`
+resource "cortex_relationship_type" "runs-on" {
+ tag = "test-relationships-runs-on"
+ name = "Runs on"
+}
+
+resource "cortex_relationship_type" "deploys-to" {
+ tag = "test-relationships-deploys-to"
+ name = "Deploys to"
+ definition_location = "DESTINATION"
+}
+
+resource "cortex_catalog_entity" "test-relationships" {
+ tag = "test-relationships"
+ name = "Relationships Test Service"
+ relationships = [%s ]
+}`, relationships)
+}
+
+func TestAccCatalogEntityResourceApiSpec(t *testing.T) {
+	tag := "test-api-spec"
+	resourceName := "cortex_catalog_entity.test-api-spec"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCatalogEntityResourceApiSpec(tag, "/payments"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttr(resourceName, "name", "API Spec Test Service"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_spec"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The spec is imported as YAML, which is semantically equal to the configured JSON.
+				ImportStateVerifyIgnore: []string{
+					"api_spec",
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCatalogEntityResourceApiSpec(tag, "/refunds"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttrSet(resourceName, "api_spec"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCatalogEntityResourceApiSpec(tag string, path string) string {
+	return fmt.Sprintf(`
+resource "cortex_catalog_entity" "test-api-spec" {
+ tag = %[1]q
+ name = "API Spec Test Service"
+ api_spec = jsonencode({
+  openapi = "3.0.3"
+  info = {
+   version = "1.0.0"
+  }
+  paths = {
+   %[2]q = {
+    get = {
+     summary = "List resources"
+     responses = {
+      "200" = {
+       description = "The resources"
+      }
+     }
+    }
+   }
+  }
+ })
+}`, tag, path)
+}
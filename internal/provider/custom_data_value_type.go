@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"reflect"
+)
+
+// Ensure the custom data value types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = CustomDataValueType{}
+var _ basetypes.StringValuableWithSemanticEquals = CustomDataValue{}
+
+// customDataValueDescription describes the value attribute of the custom data resources.
+const customDataValueDescription = "Value for the custom data, as JSON such as `jsonencode({ region = \"us-central1\" })` or `jsonencode(3)`. " +
+	"Values that are not valid JSON are sent as plain strings. Values that encode the same JSON, such as with different whitespace or key order, are equal."
+
+/***********************************************************************************************************************
+ * Type
+ **********************************************************************************************************************/
+
+// CustomDataValueType is a string type holding a custom data value as JSON. Values that are not valid JSON are plain
+// strings, so `value = "us-central1"` and `value = jsonencode("us-central1")` are the same value, while `value = "3"`
+// is the number 3 and `value = jsonencode("3")` is the string "3".
+type CustomDataValueType struct {
+	basetypes.StringType
+}
+
+func (t CustomDataValueType) String() string {
+	return "CustomDataValueType"
+}
+
+func (t CustomDataValueType) Equal(o attr.Type) bool {
+	_, ok := o.(CustomDataValueType)
+	return ok
+}
+
+func (t CustomDataValueType) ValueType(ctx context.Context) attr.Value {
+	return CustomDataValue{}
+}
+
+func (t CustomDataValueType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CustomDataValue{StringValue: in}, nil
+}
+
+func (t CustomDataValueType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return CustomDataValue{StringValue: stringValue}, nil
+}
+
+/***********************************************************************************************************************
+ * Value
+ **********************************************************************************************************************/
+
+// CustomDataValue is a custom data value encoded as JSON. Values that decode to the same JSON value are semantically
+// equal, so reformatting by the API, such as removing whitespace or reordering keys, is not reported as drift.
+type CustomDataValue struct {
+	basetypes.StringValue
+}
+
+func NewCustomDataValue(value string) CustomDataValue {
+	return CustomDataValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v CustomDataValue) Type(ctx context.Context) attr.Type {
+	return CustomDataValueType{}
+}
+
+func (v CustomDataValue) Equal(o attr.Value) bool {
+	other, ok := o.(CustomDataValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v CustomDataValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CustomDataValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return reflect.DeepEqual(v.Interface(), newValue.Interface()), diags
+}
+
+// Interface returns the value as decoded by cortex.StringToInterface, with numbers as float64 so that equal numbers
+// written differently, such as 1 and 1.0, compare equal.
+func (v CustomDataValue) Interface() interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(v.ValueString()), &value); err != nil {
+		value, _ = cortex.StringToInterface(v.ValueString())
+	}
+	return value
+}
@@ -0,0 +1,33 @@
+package provider_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCustomDataValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"identical":               {prior: `{"a":1}`, new: `{"a":1}`, expected: true},
+		"whitespace":              {prior: `{ "a": [1, 2] }`, new: `{"a":[1,2]}`, expected: true},
+		"key order":               {prior: `{"b":true,"a":"x"}`, new: `{"a":"x","b":true}`, expected: true},
+		"number formatting":       {prior: `1.0`, new: `1`, expected: true},
+		"plain and JSON string":   {prior: `us-central1`, new: `"us-central1"`, expected: true},
+		"number and string":       {prior: `3`, new: `"3"`, expected: false},
+		"boolean and string":      {prior: `true`, new: `"true"`, expected: false},
+		"different values":        {prior: `{"a":1}`, new: `{"a":2}`, expected: false},
+		"braces in a plain value": {prior: `deploy {env}`, new: `deploy {env}`, expected: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := provider.NewCustomDataValue(test.prior).StringSemanticEquals(context.Background(), provider.NewCustomDataValue(test.new))
+			assert.Empty(t, diags)
+			assert.Equal(t, test.expected, equal)
+		})
+	}
+}
@@ -82,7 +82,7 @@ func (d *DepartmentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	entity, err := d.client.Departments().Get(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read department, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read department, got error: %s", err))
 		return
 	}
 
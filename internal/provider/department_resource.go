@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -130,7 +131,11 @@ func (r *DepartmentResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Issue API request
 	entity, err := r.client.Departments().Get(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read department %s, got error: %s", data.Tag.ValueString(), err))
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read department %s, got error: %s", data.Tag.ValueString(), err))
 		return
 	}
 
@@ -158,7 +163,7 @@ func (r *DepartmentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	entity, err := r.client.Departments().Create(ctx, clientEntity.ToCreateRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create department, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create department, got error: %s", err))
 		return
 	}
 
@@ -184,7 +189,7 @@ func (r *DepartmentResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 	entity, err := r.client.Departments().Update(ctx, data.Tag.ValueString(), clientEntity.ToUpdateRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update department, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update department, got error: %s", err))
 		return
 	}
 
@@ -207,7 +212,7 @@ func (r *DepartmentResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	err := r.client.Departments().Delete(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete department, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete department, got error: %s", err))
 		return
 	}
 }
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+	"reflect"
+)
+
+// Ensure the descriptor value types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = DescriptorValueType{}
+var _ xattr.TypeWithValidate = DescriptorValueType{}
+var _ basetypes.StringValuableWithSemanticEquals = DescriptorValue{}
+
+/***********************************************************************************************************************
+ * Type
+ **********************************************************************************************************************/
+
+// DescriptorValueType is a string type holding an OpenAPI descriptor in YAML or JSON, such as the contents of a
+// cortex.yaml file. Configured values must have an info block with an x-cortex-tag.
+type DescriptorValueType struct {
+	basetypes.StringType
+}
+
+func (t DescriptorValueType) String() string {
+	return "DescriptorValueType"
+}
+
+func (t DescriptorValueType) Equal(o attr.Type) bool {
+	_, ok := o.(DescriptorValueType)
+	return ok
+}
+
+func (t DescriptorValueType) ValueType(ctx context.Context) attr.Value {
+	return DescriptorValue{}
+}
+
+func (t DescriptorValueType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DescriptorValue{StringValue: in}, nil
+}
+
+func (t DescriptorValueType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return DescriptorValue{StringValue: stringValue}, nil
+}
+
+func (t DescriptorValueType) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(valuePath, "Invalid Terraform Value", fmt.Sprintf("Unable to convert the value to a string: %s", err))
+		return diags
+	}
+	if _, err := NewDescriptorValue(value).Tag(); err != nil {
+		diags.AddAttributeError(valuePath, "Invalid Catalog Entity Descriptor", err.Error())
+	}
+	return diags
+}
+
+/***********************************************************************************************************************
+ * Value
+ **********************************************************************************************************************/
+
+// DescriptorValue is an OpenAPI descriptor in YAML or JSON. Descriptors that decode to the same document are
+// semantically equal, so formatting, comments, key order, and the OpenAPI version that is added when it is missing are
+// not reported as drift.
+type DescriptorValue struct {
+	basetypes.StringValue
+}
+
+func NewDescriptorValue(value string) DescriptorValue {
+	return DescriptorValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewDescriptorValueFromApiModel encodes a descriptor returned by the API as YAML.
+func NewDescriptorValueFromApiModel(descriptor map[string]interface{}) (DescriptorValue, error) {
+	bytes, err := yaml.Marshal(descriptor)
+	if err != nil {
+		return DescriptorValue{}, err
+	}
+	return NewDescriptorValue(string(bytes)), nil
+}
+
+func (v DescriptorValue) Type(ctx context.Context) attr.Type {
+	return DescriptorValueType{}
+}
+
+func (v DescriptorValue) Equal(o attr.Value) bool {
+	other, ok := o.(DescriptorValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v DescriptorValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DescriptorValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	prior, err := v.normalize()
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	updated, err := newValue.normalize()
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return reflect.DeepEqual(prior, updated), diags
+}
+
+// Document decodes the descriptor.
+func (v DescriptorValue) Document() (map[string]interface{}, error) {
+	doc := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(v.ValueString()), &doc); err != nil {
+		return nil, fmt.Errorf("descriptor is not valid YAML: %w", err)
+	}
+	return doc, nil
+}
+
+// Tag returns the x-cortex-tag in the info block of the descriptor.
+func (v DescriptorValue) Tag() (string, error) {
+	doc, err := v.Document()
+	if err != nil {
+		return "", err
+	}
+	return cortex.DescriptorTag(doc)
+}
+
+// normalize decodes the descriptor with the default OpenAPI version, and passes it through JSON so that equal numbers
+// written differently, such as 1 and 1.0, and keys written as numbers, booleans or strings, compare equal.
+func (v DescriptorValue) normalize() (interface{}, error) {
+	doc, err := v.Document()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := doc["openapi"]; !ok {
+		doc["openapi"] = cortex.DefaultOpenApiVersion
+	}
+
+	bytes, err := json.Marshal(cortex.StringKeys(doc))
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(bytes, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
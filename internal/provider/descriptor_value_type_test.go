@@ -0,0 +1,79 @@
+package provider_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDescriptorValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"comments and formatting": {
+			prior:    "# owned by platform\ninfo:\n    x-cortex-tag: svc\n    title: Service\n",
+			new:      "info:\n  title: Service\n  x-cortex-tag: svc\n",
+			expected: true,
+		},
+		"JSON and YAML": {
+			prior:    `{"info":{"title":"Service","x-cortex-tag":"svc"}}`,
+			new:      "info:\n  title: Service\n  x-cortex-tag: svc\n",
+			expected: true,
+		},
+		"default OpenAPI version": {
+			prior:    "info:\n  title: Service\n  x-cortex-tag: svc\n",
+			new:      "openapi: 3.0.1\ninfo:\n  title: Service\n  x-cortex-tag: svc\n",
+			expected: true,
+		},
+		"status codes": {
+			prior:    "info:\n  title: Service\n  x-cortex-tag: svc\npaths:\n  /a:\n    get:\n      responses:\n        200:\n          description: ok\n",
+			new:      "info:\n  title: Service\n  x-cortex-tag: svc\npaths:\n  /a:\n    get:\n      responses:\n        \"200\":\n          description: ok\n",
+			expected: true,
+		},
+		"boolean keys": {
+			prior:    "info:\n  title: Service\n  x-cortex-tag: svc\n  x-cortex-custom-metadata:\n    flags:\n      true: enabled\n",
+			new:      "info:\n  title: Service\n  x-cortex-tag: svc\n  x-cortex-custom-metadata:\n    flags:\n      \"true\": enabled\n",
+			expected: true,
+		},
+		"other OpenAPI version": {
+			prior:    "info:\n  title: Service\n  x-cortex-tag: svc\n",
+			new:      "openapi: 3.1.0\ninfo:\n  title: Service\n  x-cortex-tag: svc\n",
+			expected: false,
+		},
+		"unknown key changed": {
+			prior:    "info:\n  title: Service\n  x-cortex-tag: svc\n  x-cortex-future: 1\n",
+			new:      "info:\n  title: Service\n  x-cortex-tag: svc\n  x-cortex-future: 2\n",
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := provider.NewDescriptorValue(test.prior).StringSemanticEquals(context.Background(), provider.NewDescriptorValue(test.new))
+			assert.Empty(t, diags)
+			assert.Equal(t, test.expected, equal)
+		})
+	}
+}
+
+func TestDescriptorValueTypeValidate(t *testing.T) {
+	tests := map[string]struct {
+		value   string
+		invalid bool
+	}{
+		"valid":        {value: "info:\n  title: Service\n  x-cortex-tag: svc\n"},
+		"no tag":       {value: "info:\n  title: Service\n", invalid: true},
+		"no info":      {value: "openapi: 3.0.1\n", invalid: true},
+		"invalid YAML": {value: "info: [", invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := provider.DescriptorValueType{}.Validate(context.Background(), tftypes.NewValue(tftypes.String, test.value), path.Root("descriptor"))
+			assert.Equal(t, test.invalid, diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"errors"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+)
+
+// clientErrorSummary builds the summary of a diagnostic for a failed Cortex API call, naming the kind of API error
+// when there is one. The request ID is part of the error itself, so it ends up in the diagnostic detail.
+func clientErrorSummary(err error) string {
+	for _, sentinel := range []error{
+		cortex.ApiErrorNotFound,
+		cortex.ApiErrorUnauthorized,
+		cortex.ApiErrorConflict,
+		cortex.ApiErrorRateLimited,
+		cortex.ApiErrorValidation,
+	} {
+		if errors.Is(err, sentinel) {
+			return "Client Error: " + sentinel.Error()
+		}
+	}
+	return "Client Error"
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"reflect"
+)
+
+// Ensure the JSON value types fully satisfy framework interfaces.
+var _ basetypes.StringTypable = JSONValueType{}
+var _ xattr.TypeWithValidate = JSONValueType{}
+var _ basetypes.StringValuableWithSemanticEquals = JSONValue{}
+var _ validator.String = JSONObjectValidator{}
+
+/***********************************************************************************************************************
+ * Type
+ **********************************************************************************************************************/
+
+// JSONValueType is a string type holding a JSON document, such as the output of `jsonencode`. Configured values must be
+// valid JSON, or empty.
+type JSONValueType struct {
+	basetypes.StringType
+}
+
+func (t JSONValueType) String() string {
+	return "JSONValueType"
+}
+
+func (t JSONValueType) Equal(o attr.Type) bool {
+	_, ok := o.(JSONValueType)
+	return ok
+}
+
+func (t JSONValueType) ValueType(ctx context.Context) attr.Value {
+	return JSONValue{}
+}
+
+func (t JSONValueType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONValue{StringValue: in}, nil
+}
+
+func (t JSONValueType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return JSONValue{StringValue: stringValue}, nil
+}
+
+func (t JSONValueType) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(valuePath, "Invalid Terraform Value", fmt.Sprintf("Unable to convert the value to a string: %s", err))
+		return diags
+	}
+	if value != "" && !json.Valid([]byte(value)) {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON. Use the `jsonencode` function to convert a value to JSON.",
+		)
+	}
+	return diags
+}
+
+/***********************************************************************************************************************
+ * Value
+ **********************************************************************************************************************/
+
+// JSONValue is a JSON document in a string. Documents that decode to the same value are semantically equal, so
+// reformatting by the API, such as removing whitespace or reordering keys, is not reported as drift.
+type JSONValue struct {
+	basetypes.StringValue
+}
+
+func NewJSONValue(value string) JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func NewJSONNull() JSONValue {
+	return JSONValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v JSONValue) Type(ctx context.Context) attr.Type {
+	return JSONValueType{}
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	var prior, updated interface{}
+	if json.Unmarshal([]byte(v.ValueString()), &prior) != nil || json.Unmarshal([]byte(newValue.ValueString()), &updated) != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return reflect.DeepEqual(prior, updated), diags
+}
+
+/***********************************************************************************************************************
+ * Validators
+ **********************************************************************************************************************/
+
+// JSONObjectValidator validates that a JSON string value is an object, for attributes that the API stores as a map.
+// Empty and invalid values are left to JSONValueType.
+type JSONObjectValidator struct{}
+
+func (v JSONObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v JSONObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v JSONObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	var value interface{}
+	if json.Unmarshal([]byte(req.ConfigValue.ValueString()), &value) != nil {
+		return
+	}
+	if _, ok := value.(map[string]interface{}); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object Value",
+			"A JSON value was provided that is not an object. Use the `jsonencode` function to convert an object to JSON.",
+		)
+	}
+}
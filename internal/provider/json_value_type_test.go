@@ -0,0 +1,78 @@
+package provider_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestJSONValueSemanticEquals(t *testing.T) {
+	tests := map[string]struct {
+		prior    string
+		new      string
+		expected bool
+	}{
+		"identical":         {prior: `{"a":1}`, new: `{"a":1}`, expected: true},
+		"whitespace":        {prior: "{\n  \"a\": [1, 2]\n}", new: `{"a":[1,2]}`, expected: true},
+		"key order":         {prior: `{"b":{"d":1,"c":2},"a":"x"}`, new: `{"a":"x","b":{"c":2,"d":1}}`, expected: true},
+		"number formatting": {prior: `{"a":1.0}`, new: `{"a":1}`, expected: true},
+		"array order":       {prior: `[1,2]`, new: `[2,1]`, expected: false},
+		"different values":  {prior: `{"a":1}`, new: `{"a":"1"}`, expected: false},
+		"invalid JSON":      {prior: `{a}`, new: `{a}`, expected: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			equal, diags := provider.NewJSONValue(test.prior).StringSemanticEquals(context.Background(), provider.NewJSONValue(test.new))
+			assert.Empty(t, diags)
+			assert.Equal(t, test.expected, equal)
+		})
+	}
+}
+
+func TestJSONValueTypeValidate(t *testing.T) {
+	tests := map[string]struct {
+		value   tftypes.Value
+		invalid bool
+	}{
+		"object":  {value: tftypes.NewValue(tftypes.String, `{"a":1}`)},
+		"empty":   {value: tftypes.NewValue(tftypes.String, "")},
+		"null":    {value: tftypes.NewValue(tftypes.String, nil)},
+		"unknown": {value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		"invalid": {value: tftypes.NewValue(tftypes.String, `{"a":`), invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := provider.JSONValueType{}.Validate(context.Background(), test.value, path.Root("metadata"))
+			assert.Equal(t, test.invalid, diags.HasError())
+		})
+	}
+}
+
+func TestJSONObjectValidator(t *testing.T) {
+	tests := map[string]struct {
+		value   types.String
+		invalid bool
+	}{
+		"object":       {value: types.StringValue(`{"a":1}`)},
+		"empty":        {value: types.StringValue("")},
+		"null":         {value: types.StringNull()},
+		"unknown":      {value: types.StringUnknown()},
+		"invalid JSON": {value: types.StringValue(`{"a":`)},
+		"array":        {value: types.StringValue(`[1,2]`), invalid: true},
+		"string":       {value: types.StringValue(`"critical"`), invalid: true},
+		"JSON null":    {value: types.StringValue(`null`), invalid: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("metadata"), ConfigValue: test.value}
+			resp := validator.StringResponse{}
+			provider.JSONObjectValidator{}.ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, test.invalid, resp.Diagnostics.HasError())
+		})
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"os"
+	"strconv"
+	"time"
 )
 
 // Ensure CortexProvider satisfies various provider interfaces.
@@ -24,12 +29,19 @@ type CortexProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// clientOptions are applied after the provider configuration when building the Cortex API client.
+	clientOptions []cortex.OptionDelegator
 }
 
 // CortexProviderModel describes the provider data model.
 type CortexProviderModel struct {
-	BaseApiUrl types.String `tfsdk:"base_api_url"`
-	Token      types.String `tfsdk:"token"`
+	BaseApiUrl        types.String  `tfsdk:"base_api_url"`
+	Token             types.String  `tfsdk:"token"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	RequestTimeout    types.Int64   `tfsdk:"request_timeout"`
 }
 
 func (p *CortexProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,6 +61,34 @@ func (p *CortexProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a rate-limited (429) or failed (5xx) idempotent request is retried. Set to `0` to disable retries. Defaults to `%d`.", cortex.DefaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds a single request to the Cortex API may take before it is aborted. Defaults to no timeout.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second the provider sends to the Cortex API, shared across all resources and data sources. Can also be set with the `CORTEX_API_RPS` environment variable. Defaults to no limit.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of seconds to wait between retries, including waits requested by a `Retry-After` header. Defaults to `%d`.", int64(cortex.DefaultRetryMaxWait.Seconds())),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -80,14 +120,39 @@ func (p *CortexProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 		data.Token = types.StringValue(token)
 	}
+	if data.RequestsPerSecond.IsNull() {
+		if envRps := os.Getenv("CORTEX_API_RPS"); envRps != "" {
+			rps, err := strconv.ParseFloat(envRps, 64)
+			if err != nil || rps < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "invalid CORTEX_API_RPS", fmt.Sprintf("CORTEX_API_RPS must be a non-negative number, got %q", envRps))
+				return
+			}
+			data.RequestsPerSecond = types.Float64Value(rps)
+		}
+	}
 
-	// Creating a new GitLab Client from the provider configuration
-	client, err := cortex.NewClient(
+	opts := []cortex.OptionDelegator{
 		cortex.WithContext(ctx),
 		cortex.WithURL(baseApiUrl),
 		cortex.WithToken(data.Token.ValueString()),
 		cortex.WithVersion(p.version),
-	)
+	}
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		opts = append(opts, cortex.WithMaxRetries(int(data.MaxRetries.ValueInt64())))
+	}
+	if !data.RetryMaxWait.IsNull() && !data.RetryMaxWait.IsUnknown() {
+		opts = append(opts, cortex.WithRetryMaxWait(time.Duration(data.RetryMaxWait.ValueInt64())*time.Second))
+	}
+	if !data.RequestTimeout.IsNull() && !data.RequestTimeout.IsUnknown() {
+		opts = append(opts, cortex.WithRequestTimeout(time.Duration(data.RequestTimeout.ValueInt64())*time.Second))
+	}
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
+		opts = append(opts, cortex.WithRequestsPerSecond(data.RequestsPerSecond.ValueFloat64()))
+	}
+	opts = append(opts, p.clientOptions...)
+
+	// Creating a new Cortex Client from the provider configuration
+	client, err := cortex.NewClient(opts...)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Cortex API Client from provider configuration", fmt.Sprintf("The provider failed to create a new Cortex API Client from the given configuration: %+v", err))
@@ -102,21 +167,31 @@ func (p *CortexProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *CortexProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCatalogEntityResource,
+		NewCatalogEntityDescriptorResource,
 		NewDepartmentResource,
 		NewScorecardResource,
 		NewResourceDefinitionResource,
+		NewRelationshipTypeResource,
 		NewCatalogEntityCustomDataResource,
+		NewCatalogEntityCustomDataSetResource,
+		NewCatalogEntityPackagesResource,
+		NewCatalogEntityDependencyResource,
+		NewTeamResource,
 	}
 }
 
 func (p *CortexProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCatalogEntityDataSource,
+		NewCatalogEntitiesDataSource,
 		NewTeamDataSource,
+		NewTeamsDataSource,
 		NewDepartmentDataSource,
 		NewScorecardDataSource,
 		NewResourceDefinitionDataSource,
 		NewCatalogEntityCustomDataDataSource,
+		NewCatalogEntityCustomDataListDataSource,
+		NewCatalogEntityPackagesDataSource,
 	}
 }
 
@@ -127,3 +202,14 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// NewWithClientOptions returns a provider whose Cortex API client is built with additional options, e.g. a transport
+// that records or replays requests in tests.
+func NewWithClientOptions(version string, opts ...cortex.OptionDelegator) func() provider.Provider {
+	return func() provider.Provider {
+		return &CortexProvider{
+			version:       version,
+			clientOptions: opts,
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package provider_test
 
 import (
+	"errors"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex/cortextest"
 	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"io/fs"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -13,14 +19,175 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"cortex": providerserver.NewProtocol6WithError(provider.New("acctest")()),
+	"cortex": providerserver.NewProtocol6WithError(provider.NewWithClientOptions("acctest", cortex.WithTransport(testAccTransport))()),
+}
+
+// testAccTransport sends the requests of every provider instance, so that a test can record or replay them.
+var testAccTransport = &testAccRoundTripper{}
+
+type testAccRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *testAccRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if rt.next == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return rt.next.RoundTrip(req)
+}
+
+func testAccUseTransport(t *testing.T, transport http.RoundTripper) {
+	testAccTransport.next = transport
+	t.Cleanup(func() { testAccTransport.next = nil })
 }
 
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	mode, err := cortextest.CassetteModeFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cassettePath := filepath.Join("testdata", "cassettes", t.Name()+".yaml")
+	switch mode {
+	case cortextest.CassetteModeReplay:
+		cassette, err := cortextest.LoadCassette(cassettePath)
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("no cassette recorded at %s", cassettePath)
+		}
+		if err != nil {
+			t.Fatalf("could not load cassette: %v", err)
+		}
+		testAccUseTransport(t, cortextest.NewReplayer(cassette))
+		// The token is never sent anywhere, but the provider still requires one.
+		t.Setenv("CORTEX_API_TOKEN", cortextest.Token)
+		return
+	case cortextest.CassetteModeRecord:
+		token := os.Getenv("CORTEX_API_TOKEN")
+		if token == "" {
+			t.Fatalf("Missing required environment variable to record cassettes: %s", "CORTEX_API_TOKEN")
+		}
+		recorder := cortextest.NewRecorder(nil, token)
+		testAccUseTransport(t, recorder)
+		t.Cleanup(func() {
+			if err := recorder.Save(cassettePath); err != nil {
+				t.Errorf("could not save cassette: %v", err)
+			}
+		})
+		return
+	}
+
+	// Without a Cortex tenant to test against, run against an in-memory fake of the Cortex API instead.
 	if v := os.Getenv("CORTEX_API_TOKEN"); v == "" {
-		t.Fatalf("Missing required environment variable: %s", "CORTEX_API_TOKEN")
+		server := cortextest.NewServer()
+		t.Cleanup(server.Close)
+		testAccSeedFixtures(t, server)
+
+		t.Setenv("CORTEX_API_URL", server.URL)
+		t.Setenv("CORTEX_API_TOKEN", cortextest.Token)
 	}
 }
+
+// testAccSeedFixtures creates the records that data source tests expect to already exist in the Cortex tenant.
+func testAccSeedFixtures(t *testing.T, server *cortextest.Server) {
+	err := server.SeedCatalogEntity(`
+openapi: 3.0.1
+info:
+  title: Manual Test Service
+  description: A manual service for data source testing. DO NOT DELETE.
+  x-cortex-tag: manual-test
+  x-cortex-type: service
+  x-cortex-custom-metadata:
+    manual:
+      test: one
+      things:
+        - two
+        - three
+`)
+	if err != nil {
+		t.Fatalf("could not seed catalog entity: %v", err)
+	}
+
+	err = server.SeedCustomData("manual-test", cortex.CatalogEntityCustomData{
+		Key:         "manual-api",
+		Description: "Custom data set through the API for data source testing. DO NOT DELETE.",
+		Value:       map[string]interface{}{"enabled": true},
+	})
+	if err != nil {
+		t.Fatalf("could not seed custom data: %v", err)
+	}
+
+	for _, pkg := range []cortex.Package{
+		{PackageType: cortex.PackageTypeGo, Name: "github.com/dghubble/sling", Version: "1.4.1"},
+		{PackageType: cortex.PackageTypePython, Name: "requests", Version: "2.31.0"},
+	} {
+		if err := server.SeedPackage("manual-test", pkg); err != nil {
+			t.Fatalf("could not seed package: %v", err)
+		}
+	}
+
+	err = server.SeedScorecard(`
+tag: onboarding-scorecard
+name: Manual Onboarding Scorecard
+rules:
+  - title: Has an owner
+    expression: ownership != null
+    weight: 1
+`)
+	if err != nil {
+		t.Fatalf("could not seed scorecard: %v", err)
+	}
+
+	server.SeedDepartment(cortex.Department{
+		Tag:         "test-manual-department-root",
+		Name:        "Manual Test Department (Root)",
+		Description: "Department for testing data sources. DO NOT DELETE.",
+		Members:     []cortex.DepartmentMember{},
+	})
+
+	server.SeedTeam(cortex.Team{
+		TeamTag: "platform-engineering",
+		Type:    cortex.TeamTypeIdp,
+		Metadata: cortex.TeamMetadata{
+			Name:    "Platform Engineering",
+			Summary: "Team for testing data sources. DO NOT DELETE.",
+		},
+		IdpGroup: &cortex.TeamIdpGroup{
+			Group:    "platform-engineering",
+			Provider: "OKTA",
+			Members: []cortex.TeamIdpGroupMember{
+				{ID: "00u1", Name: "John Doe", Email: "john.doe@cortex.io"},
+			},
+		},
+		AdditionalMembers: []cortex.TeamMember{
+			{Name: "Jane Doe", Email: "jane.doe@cortex.io"},
+		},
+		SlackChannels: []cortex.TeamSlackChannel{
+			{Name: "platform-engineering", NotificationsEnabled: true},
+		},
+		Links: []cortex.TeamLink{},
+	})
+
+	server.SeedTeam(cortex.Team{
+		TeamTag:    "test-archived-team",
+		Type:       cortex.TeamTypeCortex,
+		IsArchived: true,
+		Metadata: cortex.TeamMetadata{
+			Name:    "Archived Test Team",
+			Summary: "Archived team for testing data sources. DO NOT DELETE.",
+		},
+		CortexTeam: &cortex.TeamCortexManaged{
+			Members: []cortex.TeamMember{
+				{Name: "Jane Doe", Email: "jane.doe@cortex.io"},
+			},
+		},
+		AdditionalMembers: []cortex.TeamMember{},
+		SlackChannels:     []cortex.TeamSlackChannel{},
+		Links:             []cortex.TeamLink{},
+	})
+
+	server.SeedResourceDefinition(cortex.ResourceDefinition{
+		Type:        "test-resource-definition",
+		Name:        "Test Resource Definition",
+		Description: "Resource definition for testing data sources. DO NOT DELETE.",
+		Schema:      map[string]interface{}{"type": "object"},
+	})
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RelationshipTypeResource{}
+var _ resource.ResourceWithImportState = &RelationshipTypeResource{}
+
+func NewRelationshipTypeResource() resource.Resource {
+	return &RelationshipTypeResource{}
+}
+
+func NewRelationshipTypeResourceModel() RelationshipTypeResourceModel {
+	return RelationshipTypeResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// RelationshipTypeResource manages a custom relationship type, which catalog entities use in their relationships.
+type RelationshipTypeResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func (r *RelationshipTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship_type"
+}
+
+func (r *RelationshipTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom relationship type between catalog entities, such as `runs-on` or `deploys-to`. " +
+			"Entities are related through it with the `relationships` attribute of `cortex_catalog_entity`.",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the relationship type, used as the `type` of entity relationships.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the relationship type.",
+				Required:            true,
+			},
+
+			// Optional attributes
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the relationship type.",
+				Optional:            true,
+			},
+			"definition_location": schema.StringAttribute{
+				MarkdownDescription: "Which entities list the relationship in their descriptors: its sources, which list their `destinations`, its destinations, which list their `sources`, or both. " +
+					"One of `" + strings.Join(cortex.RelationshipTypeDefinitionLocations, "`, `") + "`. Defaults to `" + cortex.RelationshipTypeDefinitionLocationSource + "`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(cortex.RelationshipTypeDefinitionLocationSource),
+				Validators: []validator.String{
+					stringvalidator.OneOf(cortex.RelationshipTypeDefinitionLocations...),
+				},
+			},
+			"allow_cycles": schema.BoolAttribute{
+				MarkdownDescription: "Whether entities can be related to themselves through other entities. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"create_catalog": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create a catalog page that shows the hierarchy of the relationship. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_single_source": schema.BoolAttribute{
+				MarkdownDescription: "Whether an entity can have at most one source through the relationship. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_single_destination": schema.BoolAttribute{
+				MarkdownDescription: "Whether an entity can have at most one destination through the relationship. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"source_types": schema.SetAttribute{
+				MarkdownDescription: "Types of the entities that can be sources of the relationship, such as `service`. Entities of every type can be sources when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"destination_types": schema.SetAttribute{
+				MarkdownDescription: "Types of the entities that can be destinations of the relationship. Entities of every type can be destinations when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *RelationshipTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RelationshipTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.RelationshipTypes().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read relationship type, got error: %s", err))
+		return
+	}
+
+	// Map data from the API response to the model
+	data.FromApiModel(entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.RelationshipTypes().Create(ctx, clientEntity.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create relationship type, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientEntity := data.ToApiModel()
+	entity, err := r.client.RelationshipTypes().Update(ctx, data.Tag.ValueString(), clientEntity.ToUpdateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update relationship type, got error: %s", err))
+		return
+	}
+
+	// Set computed attributes
+	data.FromApiModel(entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RelationshipTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewRelationshipTypeResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RelationshipTypes().Delete(ctx, data.Tag.ValueString())
+	if err != nil && !errors.Is(err, cortex.ApiErrorNotFound) {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete relationship type, got error: %s", err))
+		return
+	}
+}
+
+func (r *RelationshipTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
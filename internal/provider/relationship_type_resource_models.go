@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// RelationshipTypeResourceModel describes a custom relationship type between catalog entities.
+type RelationshipTypeResourceModel struct {
+	Id                  types.String   `tfsdk:"id"`
+	Tag                 types.String   `tfsdk:"tag"`
+	Name                types.String   `tfsdk:"name"`
+	Description         types.String   `tfsdk:"description"`
+	DefinitionLocation  types.String   `tfsdk:"definition_location"`
+	AllowCycles         types.Bool     `tfsdk:"allow_cycles"`
+	CreateCatalog       types.Bool     `tfsdk:"create_catalog"`
+	IsSingleSource      types.Bool     `tfsdk:"is_single_source"`
+	IsSingleDestination types.Bool     `tfsdk:"is_single_destination"`
+	SourceTypes         []types.String `tfsdk:"source_types"`
+	DestinationTypes    []types.String `tfsdk:"destination_types"`
+}
+
+func (r *RelationshipTypeResourceModel) FromApiModel(entity cortex.RelationshipType) {
+	r.Id = types.StringValue(entity.Tag)
+	r.Tag = types.StringValue(entity.Tag)
+	r.Name = types.StringValue(entity.Name)
+	r.Description = stringValueOrNull(entity.Description)
+	r.DefinitionLocation = types.StringValue(entity.DefinitionLocation)
+	r.AllowCycles = types.BoolValue(entity.AllowCycles)
+	r.CreateCatalog = types.BoolValue(entity.CreateCatalog)
+	r.IsSingleSource = types.BoolValue(entity.IsSingleSource)
+	r.IsSingleDestination = types.BoolValue(entity.IsSingleDestination)
+	r.SourceTypes = entityTypesFrom(entity.SourcesFilter)
+	r.DestinationTypes = entityTypesFrom(entity.DestinationsFilter)
+}
+
+func (r *RelationshipTypeResourceModel) ToApiModel() cortex.RelationshipType {
+	return cortex.RelationshipType{
+		Tag:                 r.Tag.ValueString(),
+		Name:                r.Name.ValueString(),
+		Description:         r.Description.ValueString(),
+		DefinitionLocation:  r.DefinitionLocation.ValueString(),
+		AllowCycles:         r.AllowCycles.ValueBool(),
+		CreateCatalog:       r.CreateCatalog.ValueBool(),
+		IsSingleSource:      r.IsSingleSource.ValueBool(),
+		IsSingleDestination: r.IsSingleDestination.ValueBool(),
+		SourcesFilter:       cortex.NewRelationshipTypeFilter(stringValues(r.SourceTypes)),
+		DestinationsFilter:  cortex.NewRelationshipTypeFilter(stringValues(r.DestinationTypes)),
+	}
+}
+
+// entityTypesFrom returns the entity types a filter allows, or nil, which is stored as null, when it allows every type.
+func entityTypesFrom(filter cortex.RelationshipTypeFilter) []types.String {
+	entityTypes := filter.EntityTypes()
+	if len(entityTypes) == 0 {
+		return nil
+	}
+	return stringValuesFrom(entityTypes)
+}
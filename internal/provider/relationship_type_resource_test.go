@@ -0,0 +1,67 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccRelationshipTypeResource(t *testing.T) {
+	resourceName := "cortex_relationship_type.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRelationshipTypeResourceConfig(`
+  description       = "Clusters a service runs on"
+  source_types      = ["service"]
+  destination_types = ["k8s-cluster"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "test-runs-on"),
+					resource.TestCheckResourceAttr(resourceName, "tag", "test-runs-on"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Runs on"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Clusters a service runs on"),
+					resource.TestCheckResourceAttr(resourceName, "definition_location", "SOURCE"),
+					resource.TestCheckResourceAttr(resourceName, "allow_cycles", "false"),
+					resource.TestCheckResourceAttr(resourceName, "source_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "source_types.*", "service"),
+					resource.TestCheckResourceAttr(resourceName, "destination_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_types.*", "k8s-cluster"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRelationshipTypeResourceConfig(`
+  definition_location   = "BOTH"
+  is_single_destination = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "definition_location", "BOTH"),
+					resource.TestCheckResourceAttr(resourceName, "is_single_destination", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_types"),
+					resource.TestCheckNoResourceAttr(resourceName, "destination_types"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRelationshipTypeResourceConfig(attributes string) string {
+	return fmt.Sprintf(`
+resource "cortex_relationship_type" "test" {
+  tag  = "test-runs-on"
+  name = "Runs on"
+%s}
+`, attributes)
+}
@@ -88,7 +88,7 @@ func (d *ResourceDefinitionDataSource) Read(ctx context.Context, req datasource.
 
 	entity, err := d.client.ResourceDefinitions().Get(ctx, data.Type.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource definition, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read resource definition, got error: %s", err))
 		return
 	}
 
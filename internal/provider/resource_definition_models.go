@@ -19,7 +19,7 @@ type ResourceDefinitionResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Source      types.String `tfsdk:"source"`
-	Schema      types.String `tfsdk:"schema"`
+	Schema      JSONValue    `tfsdk:"schema"`
 }
 
 func (r *ResourceDefinitionResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity cortex.ResourceDefinition) {
@@ -42,7 +42,7 @@ func (r *ResourceDefinitionResourceModel) FromApiModel(ctx context.Context, diag
 		diagnostics.AddError("Error parsing schema: %s", err.Error())
 		return
 	}
-	r.Schema = types.StringValue(string(sv))
+	r.Schema = NewJSONValue(string(sv))
 }
 
 func (r *ResourceDefinitionResourceModel) ToApiModel(diagnostics *diag.Diagnostics) cortex.ResourceDefinition {
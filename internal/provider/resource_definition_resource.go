@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -70,6 +71,7 @@ func (r *ResourceDefinitionResource) Schema(ctx context.Context, req resource.Sc
 			"schema": schema.StringAttribute{
 				MarkdownDescription: "Schema for the resource definition.",
 				Required:            true,
+				CustomType:          JSONValueType{},
 			},
 
 			// Optional attributes
@@ -126,7 +128,11 @@ func (r *ResourceDefinitionResource) Read(ctx context.Context, req resource.Read
 	// Issue API request
 	entity, err := r.client.ResourceDefinitions().Get(ctx, data.Type.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource definition, got error: %s", err))
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read resource definition, got error: %s", err))
 		return
 	}
 
@@ -158,7 +164,7 @@ func (r *ResourceDefinitionResource) Create(ctx context.Context, req resource.Cr
 
 	entity, err := r.client.ResourceDefinitions().Create(ctx, clientEntity.ToCreateRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resource definition, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create resource definition, got error: %s", err))
 		return
 	}
 
@@ -188,7 +194,7 @@ func (r *ResourceDefinitionResource) Update(ctx context.Context, req resource.Up
 
 	entity, err := r.client.ResourceDefinitions().Update(ctx, data.Type.ValueString(), clientEntity.ToUpdateRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update resource definition, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update resource definition, got error: %s", err))
 		return
 	}
 
@@ -214,7 +220,7 @@ func (r *ResourceDefinitionResource) Delete(ctx context.Context, req resource.De
 
 	err := r.client.ResourceDefinitions().Delete(ctx, data.Type.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource definition, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete resource definition, got error: %s", err))
 		return
 	}
 }
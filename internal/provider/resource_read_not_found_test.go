@@ -0,0 +1,133 @@
+package provider_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestProviderServer starts a provider server configured against the given Cortex API URL.
+func newTestProviderServer(t *testing.T, baseApiUrl string) tfprotov6.ProviderServer {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(provider.New("test")())()
+	assert.Nil(t, err, "could not create provider server")
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.Nil(t, err, "could not get provider schema")
+
+	config := testObjectValue(t, schemaResp.Provider.ValueType(), map[string]tftypes.Value{
+		"base_api_url": tftypes.NewValue(tftypes.String, baseApiUrl),
+		"token":        tftypes.NewValue(tftypes.String, "test"),
+	})
+	configResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	assert.Nil(t, err, "could not configure provider")
+	assert.Empty(t, configResp.Diagnostics, "unexpected diagnostics configuring provider")
+
+	return server
+}
+
+// testObjectValue builds a DynamicValue of the given object type, leaving every attribute not in values null.
+func testObjectValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) tfprotov6.DynamicValue {
+	objectType, ok := typ.(tftypes.Object)
+	assert.True(t, ok, "expected an object type")
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	dv, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attrs))
+	assert.Nil(t, err, "could not build dynamic value")
+	return dv
+}
+
+func TestResourceReadRemovesMissingResourceFromState(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"NOT_FOUND","message":"not found","requestId":"test"}`))
+	}))
+	defer ts.Close()
+
+	tests := map[string]map[string]tftypes.Value{
+		"cortex_catalog_entity": {
+			"id":  tftypes.NewValue(tftypes.String, "test-entity"),
+			"tag": tftypes.NewValue(tftypes.String, "test-entity"),
+		},
+		"cortex_catalog_entity_custom_data": {
+			"id":  tftypes.NewValue(tftypes.String, "test-entity:test-key"),
+			"tag": tftypes.NewValue(tftypes.String, "test-entity"),
+			"key": tftypes.NewValue(tftypes.String, "test-key"),
+		},
+		"cortex_catalog_entity_custom_data_set": {
+			"id":  tftypes.NewValue(tftypes.String, "test-entity"),
+			"tag": tftypes.NewValue(tftypes.String, "test-entity"),
+		},
+		"cortex_catalog_entity_dependency": {
+			"id":         tftypes.NewValue(tftypes.String, "test-caller:test-callee"),
+			"caller_tag": tftypes.NewValue(tftypes.String, "test-caller"),
+			"callee_tag": tftypes.NewValue(tftypes.String, "test-callee"),
+		},
+		"cortex_catalog_entity_descriptor": {
+			"id":  tftypes.NewValue(tftypes.String, "test-entity"),
+			"tag": tftypes.NewValue(tftypes.String, "test-entity"),
+		},
+		"cortex_catalog_entity_packages": {
+			"id":  tftypes.NewValue(tftypes.String, "test-entity"),
+			"tag": tftypes.NewValue(tftypes.String, "test-entity"),
+		},
+		"cortex_department": {
+			"id":  tftypes.NewValue(tftypes.String, "test-department"),
+			"tag": tftypes.NewValue(tftypes.String, "test-department"),
+		},
+		"cortex_relationship_type": {
+			"id":  tftypes.NewValue(tftypes.String, "test-relationship-type"),
+			"tag": tftypes.NewValue(tftypes.String, "test-relationship-type"),
+		},
+		"cortex_resource_definition": {
+			"id":   tftypes.NewValue(tftypes.String, "test-definition"),
+			"type": tftypes.NewValue(tftypes.String, "test-definition"),
+		},
+		"cortex_scorecard": {
+			"id":  tftypes.NewValue(tftypes.String, "test-scorecard"),
+			"tag": tftypes.NewValue(tftypes.String, "test-scorecard"),
+		},
+		"cortex_team": {
+			"id":  tftypes.NewValue(tftypes.String, "test-team"),
+			"tag": tftypes.NewValue(tftypes.String, "test-team"),
+		},
+	}
+
+	ctx := context.Background()
+	server := newTestProviderServer(t, ts.URL)
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.Nil(t, err, "could not get provider schema")
+
+	for typeName, values := range tests {
+		t.Run(typeName, func(t *testing.T) {
+			resourceSchema, ok := schemaResp.ResourceSchemas[typeName]
+			assert.True(t, ok, "missing schema for %s", typeName)
+
+			state := testObjectValue(t, resourceSchema.ValueType(), values)
+			resp, err := server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+				TypeName:     typeName,
+				CurrentState: &state,
+			})
+			assert.Nil(t, err)
+			assert.Empty(t, resp.Diagnostics, "expected no diagnostics for a resource deleted out-of-band")
+
+			newState, err := resp.NewState.Unmarshal(resourceSchema.ValueType())
+			assert.Nil(t, err, "could not read new state")
+			assert.True(t, newState.IsNull(), "expected %s to be removed from state", typeName)
+		})
+	}
+}
@@ -164,7 +164,7 @@ func (d *ScorecardDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	entity, err := d.client.Scorecards().Get(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read scorecard, got error: %s", err))
 		return
 	}
 	data.FromApiModel(ctx, &resp.Diagnostics, &entity)
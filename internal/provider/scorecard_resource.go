@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
@@ -208,7 +209,11 @@ func (r *ScorecardResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Issue API request
 	entity, err := r.client.Scorecards().Get(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scorecard, got error: %s", err))
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read scorecard, got error: %s", err))
 		return
 	}
 
@@ -236,7 +241,7 @@ func (r *ScorecardResource) Create(ctx context.Context, req resource.CreateReque
 
 	scorecard, err := r.client.Scorecards().Upsert(ctx, clientEntity)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scorecard, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create scorecard, got error: %s", err))
 		return
 	}
 
@@ -262,7 +267,7 @@ func (r *ScorecardResource) Update(ctx context.Context, req resource.UpdateReque
 
 	scorecard, err := r.client.Scorecards().Upsert(ctx, clientEntity)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scorecard, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update scorecard, got error: %s", err))
 		return
 	}
 
@@ -284,7 +289,7 @@ func (r *ScorecardResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	err := r.client.Scorecards().Delete(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scorecard, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete scorecard, got error: %s", err))
 		return
 	}
 }
@@ -23,29 +23,242 @@ type TeamDataSource struct {
 
 // TeamDataSourceModel describes the data source data model.
 type TeamDataSourceModel struct {
-	Id  types.String `tfsdk:"id"`
-	Tag types.String `tfsdk:"tag"`
+	Id                types.String                    `tfsdk:"id"`
+	Tag               types.String                    `tfsdk:"tag"`
+	Name              types.String                    `tfsdk:"name"`
+	Summary           types.String                    `tfsdk:"summary"`
+	Description       types.String                    `tfsdk:"description"`
+	Type              types.String                    `tfsdk:"type"`
+	Archived          types.Bool                      `tfsdk:"archived"`
+	AdditionalMembers []TeamMemberResourceModel       `tfsdk:"additional_members"`
+	SlackChannels     []TeamSlackChannelResourceModel `tfsdk:"slack_channels"`
+	Links             []TeamLinkResourceModel         `tfsdk:"links"`
+	IdpGroup          *TeamIdpGroupDataSourceModel    `tfsdk:"idp_group"`
+	CortexTeam        *TeamCortexManagedResourceModel `tfsdk:"cortex_team"`
+}
+
+type TeamIdpGroupDataSourceModel struct {
+	Group    types.String                        `tfsdk:"group"`
+	Provider types.String                        `tfsdk:"provider"`
+	Members  []TeamIdpGroupMemberDataSourceModel `tfsdk:"members"`
+}
+
+type TeamIdpGroupMemberDataSourceModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+func (o *TeamDataSourceModel) FromApiModel(entity *cortex.Team) {
+	o.Id = types.StringValue(entity.TeamTag)
+	o.Tag = types.StringValue(entity.TeamTag)
+	o.Name = types.StringValue(entity.Metadata.Name)
+	o.Summary = types.StringValue(entity.Metadata.Summary)
+	o.Description = types.StringValue(entity.Metadata.Description)
+	o.Type = types.StringValue(entity.Type)
+	o.Archived = types.BoolValue(entity.IsArchived)
+
+	o.AdditionalMembers = make([]TeamMemberResourceModel, len(entity.AdditionalMembers))
+	for i, member := range entity.AdditionalMembers {
+		m := TeamMemberResourceModel{}
+		o.AdditionalMembers[i] = m.FromApiModel(&member)
+	}
+	o.SlackChannels = make([]TeamSlackChannelResourceModel, len(entity.SlackChannels))
+	for i, channel := range entity.SlackChannels {
+		c := TeamSlackChannelResourceModel{}
+		o.SlackChannels[i] = c.FromApiModel(&channel)
+	}
+	o.Links = make([]TeamLinkResourceModel, len(entity.Links))
+	for i, link := range entity.Links {
+		l := TeamLinkResourceModel{}
+		o.Links[i] = l.FromApiModel(&link)
+	}
+
+	o.IdpGroup = nil
+	if entity.IdpGroup != nil && entity.IdpGroup.Group != "" {
+		o.IdpGroup = &TeamIdpGroupDataSourceModel{
+			Group:    types.StringValue(entity.IdpGroup.Group),
+			Provider: types.StringValue(entity.IdpGroup.Provider),
+			Members:  make([]TeamIdpGroupMemberDataSourceModel, len(entity.IdpGroup.Members)),
+		}
+		for i, member := range entity.IdpGroup.Members {
+			o.IdpGroup.Members[i] = TeamIdpGroupMemberDataSourceModel{
+				Id:    types.StringValue(member.ID),
+				Name:  types.StringValue(member.Name),
+				Email: types.StringValue(member.Email),
+			}
+		}
+	}
+	o.CortexTeam = nil
+	if entity.CortexTeam != nil {
+		o.CortexTeam = &TeamCortexManagedResourceModel{
+			Members: make([]TeamMemberResourceModel, len(entity.CortexTeam.Members)),
+		}
+		for i, member := range entity.CortexTeam.Members {
+			m := TeamMemberResourceModel{}
+			o.CortexTeam.Members[i] = m.FromApiModel(&member)
+		}
+	}
 }
 
 func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_team"
 }
 
+func teamMemberDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the member.",
+			Computed:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the member.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A short description of the member.",
+			Computed:            true,
+		},
+	}
+}
+
 func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := teamDataSourceAttributes()
+	attributes["tag"] = schema.StringAttribute{
+		MarkdownDescription: "Tag of the team",
+		Required:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Team data source",
+		Attributes:          attributes,
+	}
+}
 
-		Attributes: map[string]schema.Attribute{
-			// Required
-			"tag": schema.StringAttribute{
-				MarkdownDescription: "Tag of the team",
-				Required:            true,
+// teamDataSourceAttributes returns the computed attributes of a team, shared by the cortex_team and cortex_teams data
+// sources.
+func teamDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"tag": schema.StringAttribute{
+			MarkdownDescription: "Tag of the team.",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the team.",
+			Computed:            true,
+		},
+		"summary": schema.StringAttribute{
+			MarkdownDescription: "A short summary of the team.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the team.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Where the team's membership comes from: `IDP` for an identity provider group, or `CORTEX` if it is managed in Cortex.",
+			Computed:            true,
+		},
+		"archived": schema.BoolAttribute{
+			MarkdownDescription: "Whether the team is archived.",
+			Computed:            true,
+		},
+		"additional_members": schema.ListNestedAttribute{
+			MarkdownDescription: "Members of the team in addition to those from its IdP group or Cortex-managed membership.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: teamMemberDataSourceAttributes(),
 			},
-
-			// Computed
-			"id": schema.StringAttribute{
-				Computed: true,
+		},
+		"slack_channels": schema.ListNestedAttribute{
+			MarkdownDescription: "Slack channels of the team.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the Slack channel.",
+						Computed:            true,
+					},
+					"notifications_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether notifications are sent to the channel.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"links": schema.ListNestedAttribute{
+			MarkdownDescription: "Links of the team.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the link.",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the link.",
+						Computed:            true,
+					},
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL of the link.",
+						Computed:            true,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the link.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"idp_group": schema.SingleNestedAttribute{
+			MarkdownDescription: "Identity provider group that the team's membership comes from, if any.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"group": schema.StringAttribute{
+					MarkdownDescription: "Name of the group in the identity provider.",
+					Computed:            true,
+				},
+				"provider": schema.StringAttribute{
+					MarkdownDescription: "Identity provider of the group.",
+					Computed:            true,
+				},
+				"members": schema.ListNestedAttribute{
+					MarkdownDescription: "Members of the group.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								MarkdownDescription: "ID of the member in the identity provider.",
+								Computed:            true,
+							},
+							"name": schema.StringAttribute{
+								MarkdownDescription: "Name of the member.",
+								Computed:            true,
+							},
+							"email": schema.StringAttribute{
+								MarkdownDescription: "Email of the member.",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"cortex_team": schema.SingleNestedAttribute{
+			MarkdownDescription: "Cortex-managed membership of the team, if any.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"members": schema.ListNestedAttribute{
+					MarkdownDescription: "Members of the team.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: teamMemberDataSourceAttributes(),
+					},
+				},
 			},
 		},
 	}
@@ -83,11 +296,10 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	teamResponse, err := d.client.Teams().Get(ctx, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
-	data.Id = types.StringValue(teamResponse.TeamTag)
-	data.Tag = types.StringValue(teamResponse.TeamTag)
+	data.FromApiModel(teamResponse)
 
 	// Write to TF state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -1,23 +1,38 @@
 package provider_test
 
-//func TestAccTeamDataSource(t *testing.T) {
-//	resource.Test(t, resource.TestCase{
-//		PreCheck:                 func() { testAccPreCheck(t) },
-//		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-//		Steps: []resource.TestStep{
-//			// Read testing
-//			{
-//				Config: testAccTeamDataSourceConfig,
-//				Check: resource.ComposeAggregateTestCheckFunc(
-//					resource.TestCheckResourceAttr("data.cortex_team.platform_engineering", "tag", "platform_engineering"),
-//				),
-//			},
-//		},
-//	})
-//}
-//
-//const testAccTeamDataSourceConfig = `
-//data "cortex_team" "engineering" {
-//  tag = "platform_engineering"
-//}
-//`
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccTeamDataSource(t *testing.T) {
+	dataSourceName := "data.cortex_team.platform_engineering"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tag", "platform-engineering"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Platform Engineering"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "IDP"),
+					resource.TestCheckResourceAttr(dataSourceName, "archived", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "idp_group.group", "platform-engineering"),
+					resource.TestCheckResourceAttr(dataSourceName, "idp_group.members.0.email", "john.doe@cortex.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "additional_members.0.email", "jane.doe@cortex.io"),
+					resource.TestCheckResourceAttr(dataSourceName, "slack_channels.0.name", "platform-engineering"),
+					resource.TestCheckResourceAttr(dataSourceName, "links.#", "0"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "cortex_team"),
+				),
+			},
+		},
+	})
+}
+
+const testAccTeamDataSourceConfig = `
+data "cortex_team" "platform_engineering" {
+  tag = "platform-engineering"
+}
+`
@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamResource{}
+var _ resource.ResourceWithImportState = &TeamResource{}
+
+func NewTeamResource() resource.Resource {
+	return &TeamResource{}
+}
+
+func NewTeamResourceModel() TeamResourceModel {
+	return TeamResourceModel{}
+}
+
+/***********************************************************************************************************************
+ * Types
+ **********************************************************************************************************************/
+
+// TeamResource defines the resource implementation.
+type TeamResource struct {
+	client *cortex.HttpClient
+}
+
+/***********************************************************************************************************************
+ * Schema
+ **********************************************************************************************************************/
+
+func teamMemberAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the member.",
+			Required:            true,
+		},
+		"email": schema.StringAttribute{
+			MarkdownDescription: "Email of the member.",
+			Required:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A short description of the member.",
+			Optional:            true,
+		},
+	}
+}
+
+func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Team. Membership comes either from an identity provider group (`idp_group`) or is managed in Cortex (`cortex_team`).",
+
+		Attributes: map[string]schema.Attribute{
+			// Required attributes
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the team.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team.",
+				Required:            true,
+			},
+
+			// Optional attributes
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "A short summary of the team.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the team.",
+				Optional:            true,
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team is archived. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"additional_members": schema.ListNestedAttribute{
+				MarkdownDescription: "Members of the team in addition to those from its IdP group or Cortex-managed membership.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamMemberAttributes(),
+				},
+			},
+			"slack_channels": schema.ListNestedAttribute{
+				MarkdownDescription: "Slack channels of the team.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the Slack channel.",
+							Required:            true,
+						},
+						"notifications_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether notifications are sent to the channel. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"links": schema.ListNestedAttribute{
+				MarkdownDescription: "Links of the team.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the link.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the link, e.g. `runbook` or `documentation`.",
+							Optional:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the link.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the link.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"idp_group": schema.SingleNestedAttribute{
+				MarkdownDescription: "Identity provider group that the team's membership comes from. Conflicts with `cortex_team`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"group": schema.StringAttribute{
+						MarkdownDescription: "Name of the group in the identity provider.",
+						Required:            true,
+					},
+					"provider": schema.StringAttribute{
+						MarkdownDescription: "Identity provider of the group, e.g. `OKTA` or `GOOGLE`.",
+						Required:            true,
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("cortex_team")),
+				},
+			},
+			"cortex_team": schema.SingleNestedAttribute{
+				MarkdownDescription: "Members of a team whose membership is managed in Cortex. Conflicts with `idp_group`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"members": schema.ListNestedAttribute{
+						MarkdownDescription: "Members of the team.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: teamMemberAttributes(),
+						},
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+
+			// Computed attributes
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+/***********************************************************************************************************************
+ * Methods
+ **********************************************************************************************************************/
+
+func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := NewTeamResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Issue API request
+	entity, err := r.client.Teams().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		if errors.Is(err, cortex.ApiErrorNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read team %s, got error: %s", data.Tag.ValueString(), err))
+		return
+	}
+
+	// Map entity to resource model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := NewTeamResourceModel()
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Teams().Create(ctx, data.ToCreateRequest(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to create team, got error: %s", err))
+		return
+	}
+
+	// Save the team right away, so that it is tracked, and replaced on the next apply, if archiving it fails
+	data.Id = types.StringValue(data.Tag.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Re-fetch the team, since the create response does not reflect its archived state
+	entity, err := r.client.Teams().Get(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to read team %s after creating it, got error: %s", data.Tag.ValueString(), err))
+		return
+	}
+	if entity.IsArchived != data.Archived.ValueBool() {
+		if err := r.setArchived(ctx, data.Tag.ValueString(), data.Archived.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to archive team %s, got error: %s", data.Tag.ValueString(), err))
+			return
+		}
+		entity.IsArchived = data.Archived.ValueBool()
+	}
+
+	// Map entity to resource model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := NewTeamResourceModel()
+	state := NewTeamResourceModel()
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag := data.Tag.ValueString()
+	archived := data.Archived.ValueBool()
+
+	// An archived team is read-only, so unarchive it before updating it, and archive it only once it is updated.
+	if state.Archived.ValueBool() {
+		if err := r.setArchived(ctx, tag, false); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to unarchive team %s, got error: %s", tag, err))
+			return
+		}
+	}
+
+	entity, err := r.client.Teams().Update(ctx, tag, data.ToUpdateRequest(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to update team, got error: %s", err))
+		return
+	}
+
+	if archived {
+		if err := r.setArchived(ctx, tag, true); err != nil {
+			resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to archive team %s, got error: %s", tag, err))
+			return
+		}
+	}
+	entity.IsArchived = archived
+
+	// Map entity to resource model
+	data.FromApiModel(ctx, &resp.Diagnostics, entity)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := NewTeamResourceModel()
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Teams().Delete(ctx, data.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to delete team, got error: %s", err))
+		return
+	}
+}
+
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tag"), req, resp)
+}
+
+func (r *TeamResource) setArchived(ctx context.Context, tag string, archived bool) error {
+	if archived {
+		return r.client.Teams().Archive(ctx, tag)
+	}
+	return r.client.Teams().Unarchive(ctx, tag)
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// TeamResourceModel describes the team data model within Terraform.
+type TeamResourceModel struct {
+	Id                types.String                    `tfsdk:"id"`
+	Tag               types.String                    `tfsdk:"tag"`
+	Name              types.String                    `tfsdk:"name"`
+	Summary           types.String                    `tfsdk:"summary"`
+	Description       types.String                    `tfsdk:"description"`
+	Archived          types.Bool                      `tfsdk:"archived"`
+	AdditionalMembers []TeamMemberResourceModel       `tfsdk:"additional_members"`
+	SlackChannels     []TeamSlackChannelResourceModel `tfsdk:"slack_channels"`
+	Links             []TeamLinkResourceModel         `tfsdk:"links"`
+	IdpGroup          types.Object                    `tfsdk:"idp_group"`
+	CortexTeam        types.Object                    `tfsdk:"cortex_team"`
+}
+
+func (r *TeamResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.Team) {
+	r.Id = types.StringValue(entity.TeamTag)
+	r.Tag = types.StringValue(entity.TeamTag)
+	r.Name = types.StringValue(entity.Metadata.Name)
+	r.Summary = stringValueOrNull(entity.Metadata.Summary)
+	r.Description = stringValueOrNull(entity.Metadata.Description)
+	r.Archived = types.BoolValue(entity.IsArchived)
+
+	// The API returns empty lists for lists that were never set, so an empty list stays empty, as configured, and a
+	// null one stays null.
+	if r.AdditionalMembers != nil {
+		r.AdditionalMembers = []TeamMemberResourceModel{}
+	}
+	for _, member := range entity.AdditionalMembers {
+		m := TeamMemberResourceModel{}
+		r.AdditionalMembers = append(r.AdditionalMembers, m.FromApiModel(&member))
+	}
+	if r.SlackChannels != nil {
+		r.SlackChannels = []TeamSlackChannelResourceModel{}
+	}
+	for _, channel := range entity.SlackChannels {
+		c := TeamSlackChannelResourceModel{}
+		r.SlackChannels = append(r.SlackChannels, c.FromApiModel(&channel))
+	}
+	if r.Links != nil {
+		r.Links = []TeamLinkResourceModel{}
+	}
+	for _, link := range entity.Links {
+		l := TeamLinkResourceModel{}
+		r.Links = append(r.Links, l.FromApiModel(&link))
+	}
+
+	idpGroup := TeamIdpGroupResourceModel{}
+	r.IdpGroup = idpGroup.FromApiModel(ctx, diagnostics, entity.IdpGroup)
+	cortexTeam := TeamCortexManagedResourceModel{}
+	r.CortexTeam = cortexTeam.FromApiModel(ctx, diagnostics, entity.CortexTeam)
+}
+
+func (r *TeamResourceModel) ToCreateRequest(ctx context.Context) cortex.CreateTeamRequest {
+	update := r.ToUpdateRequest(ctx)
+	return cortex.CreateTeamRequest{
+		TeamTag:           r.Tag.ValueString(),
+		Type:              update.Type,
+		Metadata:          update.Metadata,
+		IsArchived:        r.Archived.ValueBool(),
+		AdditionalMembers: update.AdditionalMembers,
+		SlackChannels:     update.SlackChannels,
+		Links:             update.Links,
+		CortexTeam:        update.CortexTeam,
+		IdpGroup:          update.IdpGroup,
+	}
+}
+
+func (r *TeamResourceModel) ToUpdateRequest(ctx context.Context) cortex.UpdateTeamRequest {
+	req := cortex.UpdateTeamRequest{
+		Metadata: cortex.TeamMetadata{
+			Name:        r.Name.ValueString(),
+			Summary:     r.Summary.ValueString(),
+			Description: r.Description.ValueString(),
+		},
+		AdditionalMembers: []cortex.TeamMember{},
+		SlackChannels:     []cortex.TeamSlackChannel{},
+		Links:             []cortex.TeamLink{},
+		Type:              cortex.TeamTypeCortex,
+	}
+	for _, member := range r.AdditionalMembers {
+		req.AdditionalMembers = append(req.AdditionalMembers, member.ToApiModel())
+	}
+	for _, channel := range r.SlackChannels {
+		req.SlackChannels = append(req.SlackChannels, channel.ToApiModel())
+	}
+	for _, link := range r.Links {
+		req.Links = append(req.Links, link.ToApiModel())
+	}
+
+	defaultObjOptions := getDefaultObjectOptions()
+	if !r.IdpGroup.IsNull() && !r.IdpGroup.IsUnknown() {
+		idpGroup := TeamIdpGroupResourceModel{}
+		r.IdpGroup.As(ctx, &idpGroup, defaultObjOptions)
+		req.Type = cortex.TeamTypeIdp
+		req.IdpGroup = idpGroup.ToApiModel()
+	} else {
+		cortexTeam := TeamCortexManagedResourceModel{}
+		if !r.CortexTeam.IsNull() && !r.CortexTeam.IsUnknown() {
+			r.CortexTeam.As(ctx, &cortexTeam, defaultObjOptions)
+		}
+		req.CortexTeam = cortexTeam.ToApiModel()
+	}
+	return req
+}
+
+/***********************************************************************************************************************
+ * Members
+ **********************************************************************************************************************/
+
+type TeamMemberResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (o *TeamMemberResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"email":       types.StringType,
+		"description": types.StringType,
+	}
+}
+
+func (o *TeamMemberResourceModel) ToApiModel() cortex.TeamMember {
+	return cortex.TeamMember{
+		Name:        o.Name.ValueString(),
+		Email:       o.Email.ValueString(),
+		Description: o.Description.ValueString(),
+	}
+}
+
+func (o *TeamMemberResourceModel) FromApiModel(member *cortex.TeamMember) TeamMemberResourceModel {
+	return TeamMemberResourceModel{
+		Name:        types.StringValue(member.Name),
+		Email:       types.StringValue(member.Email),
+		Description: stringValueOrNull(member.Description),
+	}
+}
+
+/***********************************************************************************************************************
+ * Slack Channels
+ **********************************************************************************************************************/
+
+type TeamSlackChannelResourceModel struct {
+	Name                 types.String `tfsdk:"name"`
+	NotificationsEnabled types.Bool   `tfsdk:"notifications_enabled"`
+}
+
+func (o *TeamSlackChannelResourceModel) ToApiModel() cortex.TeamSlackChannel {
+	return cortex.TeamSlackChannel{
+		Name:                 o.Name.ValueString(),
+		NotificationsEnabled: o.NotificationsEnabled.ValueBool(),
+	}
+}
+
+func (o *TeamSlackChannelResourceModel) FromApiModel(channel *cortex.TeamSlackChannel) TeamSlackChannelResourceModel {
+	return TeamSlackChannelResourceModel{
+		Name:                 types.StringValue(channel.Name),
+		NotificationsEnabled: types.BoolValue(channel.NotificationsEnabled),
+	}
+}
+
+/***********************************************************************************************************************
+ * Links
+ **********************************************************************************************************************/
+
+type TeamLinkResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Url         types.String `tfsdk:"url"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (o *TeamLinkResourceModel) ToApiModel() cortex.TeamLink {
+	return cortex.TeamLink{
+		Name:        o.Name.ValueString(),
+		Type:        o.Type.ValueString(),
+		Url:         o.Url.ValueString(),
+		Description: o.Description.ValueString(),
+	}
+}
+
+func (o *TeamLinkResourceModel) FromApiModel(link *cortex.TeamLink) TeamLinkResourceModel {
+	return TeamLinkResourceModel{
+		Name:        types.StringValue(link.Name),
+		Type:        stringValueOrNull(link.Type),
+		Url:         types.StringValue(link.Url),
+		Description: stringValueOrNull(link.Description),
+	}
+}
+
+/***********************************************************************************************************************
+ * IdP Group
+ **********************************************************************************************************************/
+
+type TeamIdpGroupResourceModel struct {
+	Group    types.String `tfsdk:"group"`
+	Provider types.String `tfsdk:"provider"`
+}
+
+func (o *TeamIdpGroupResourceModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"group":    types.StringType,
+		"provider": types.StringType,
+	}
+}
+
+func (o *TeamIdpGroupResourceModel) ToApiModel() *cortex.TeamIdpGroup {
+	return &cortex.TeamIdpGroup{
+		Group:    o.Group.ValueString(),
+		Provider: o.Provider.ValueString(),
+	}
+}
+
+func (o *TeamIdpGroupResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.TeamIdpGroup) types.Object {
+	if entity == nil || entity.Group == "" {
+		return types.ObjectNull(o.AttrTypes())
+	}
+	obj := TeamIdpGroupResourceModel{
+		Group:    types.StringValue(entity.Group),
+		Provider: types.StringValue(entity.Provider),
+	}
+	objectValue, d := types.ObjectValueFrom(ctx, obj.AttrTypes(), &obj)
+	diagnostics.Append(d...)
+	return objectValue
+}
+
+/***********************************************************************************************************************
+ * Cortex-managed membership
+ **********************************************************************************************************************/
+
+type TeamCortexManagedResourceModel struct {
+	Members []TeamMemberResourceModel `tfsdk:"members"`
+}
+
+func (o *TeamCortexManagedResourceModel) AttrTypes() map[string]attr.Type {
+	m := TeamMemberResourceModel{}
+	return map[string]attr.Type{
+		"members": types.ListType{ElemType: types.ObjectType{AttrTypes: m.AttrTypes()}},
+	}
+}
+
+func (o *TeamCortexManagedResourceModel) ToApiModel() *cortex.TeamCortexManaged {
+	members := make([]cortex.TeamMember, len(o.Members))
+	for i, member := range o.Members {
+		members[i] = member.ToApiModel()
+	}
+	return &cortex.TeamCortexManaged{Members: members}
+}
+
+// FromApiModel returns a null object when the team has no Cortex-managed members, since the API reports an empty
+// member list for every team that is not backed by an IdP group.
+func (o *TeamCortexManagedResourceModel) FromApiModel(ctx context.Context, diagnostics *diag.Diagnostics, entity *cortex.TeamCortexManaged) types.Object {
+	if entity == nil || len(entity.Members) == 0 {
+		return types.ObjectNull(o.AttrTypes())
+	}
+	obj := TeamCortexManagedResourceModel{
+		Members: make([]TeamMemberResourceModel, len(entity.Members)),
+	}
+	for i, member := range entity.Members {
+		m := TeamMemberResourceModel{}
+		obj.Members[i] = m.FromApiModel(&member)
+	}
+	objectValue, d := types.ObjectValueFrom(ctx, obj.AttrTypes(), &obj)
+	diagnostics.Append(d...)
+	return objectValue
+}
@@ -0,0 +1,131 @@
+package provider_test
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccTeamResource(t *testing.T) {
+	tag := "test-team"
+	resourceName := "cortex_team." + tag
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamResourceConfig(tag, "Test Team", "A team for testing", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag", tag),
+					resource.TestCheckResourceAttr(resourceName, "name", "Test Team"),
+					resource.TestCheckResourceAttr(resourceName, "summary", "A team for testing"),
+					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cortex_team.members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cortex_team.members.0.email", "john.doe@cortex.io"),
+					resource.TestCheckResourceAttr(resourceName, "additional_members.0.email", "jane.doe@cortex.io"),
+					resource.TestCheckResourceAttr(resourceName, "slack_channels.0.name", "test-team-alerts"),
+					resource.TestCheckResourceAttr(resourceName, "slack_channels.0.notifications_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "links.0.url", "https://cortex.io"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     tag,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccTeamResourceConfig(tag, "Renamed Test Team", "A team for testing", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Renamed Test Team"),
+					resource.TestCheckResourceAttr(resourceName, "archived", "true"),
+				),
+			},
+			// Update of an archived team
+			{
+				Config: testAccTeamResourceConfig(tag, "Renamed Test Team", "An archived team for testing", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "An archived team for testing"),
+					resource.TestCheckResourceAttr(resourceName, "archived", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamResourceIdpGroup(t *testing.T) {
+	tag := "test-team-idp"
+	resourceName := "cortex_team." + tag
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cortex_team" %[1]q {
+  tag  = %[1]q
+  name = "Test IdP Team"
+
+  idp_group = {
+    group    = "test-team-idp"
+    provider = "OKTA"
+  }
+}
+`, tag),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "idp_group.group", "test-team-idp"),
+					resource.TestCheckResourceAttr(resourceName, "idp_group.provider", "OKTA"),
+					resource.TestCheckNoResourceAttr(resourceName, "cortex_team"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamResourceConfig(tag string, name string, description string, archived bool) string {
+	return fmt.Sprintf(`
+resource "cortex_team" %[1]q {
+  tag         = %[1]q
+  name        = %[2]q
+  summary     = "A team for testing"
+  description = %[3]q
+  archived    = %[4]t
+
+  cortex_team = {
+    members = [
+      {
+        name  = "John Doe"
+        email = "john.doe@cortex.io"
+      }
+    ]
+  }
+
+  additional_members = [
+    {
+      name        = "Jane Doe"
+      email       = "jane.doe@cortex.io"
+      description = "Helps out"
+    }
+  ]
+
+  slack_channels = [
+    {
+      name                  = "test-team-alerts"
+      notifications_enabled = true
+    }
+  ]
+
+  links = [
+    {
+      name = "Home"
+      type = "documentation"
+      url  = "https://cortex.io"
+    }
+  ]
+}
+`, tag, name, description, archived)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"regexp"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamsDataSource{}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+// TeamsDataSource defines the data source implementation.
+type TeamsDataSource struct {
+	client *cortex.HttpClient
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Teams data source. Lists every team in Cortex, optionally filtered, keyed by tag.",
+
+		Attributes: map[string]schema.Attribute{
+			// Optional
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Only return teams whose archived status matches. Returns both archived and active teams if unset.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return teams whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"tag_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return teams whose tag matches this regular expression.",
+				Optional:            true,
+			},
+			"member_email": schema.StringAttribute{
+				MarkdownDescription: "Only return teams with an additional, IdP group or Cortex-managed member with this email. Matching ignores case.",
+				Optional:            true,
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"teams": schema.MapNestedAttribute{
+				MarkdownDescription: "Teams matching the filters, keyed by tag.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cortex.HttpClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := TeamsFilter{
+		MemberEmail: data.MemberEmail.ValueString(),
+	}
+	if !data.Archived.IsNull() {
+		archived := data.Archived.ValueBool()
+		filter.Archived = &archived
+	}
+	filter.NameRegex = compileRegexAttribute(resp, path.Root("name_regex"), data.NameRegex.ValueString())
+	filter.TagRegex = compileRegexAttribute(resp, path.Root("tag_regex"), data.TagRegex.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamsResponse, err := d.client.Teams().List(ctx, &cortex.TeamListParams{IncludeTeamsWithoutMembers: true})
+	if err != nil {
+		resp.Diagnostics.AddError(clientErrorSummary(err), fmt.Sprintf("Unable to list teams, got error: %s", err))
+		return
+	}
+	data.FromApiModel(teamsResponse.Teams, filter)
+
+	// Write to TF state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// compileRegexAttribute compiles the regular expression in the given attribute, returning nil if it is empty and adding
+// an attribute error if it is invalid.
+func compileRegexAttribute(resp *datasource.ReadResponse, attributePath path.Path, expr string) *regexp.Regexp {
+	if expr == "" {
+		return nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attributePath, "Invalid Regular Expression", fmt.Sprintf("Unable to compile %q: %s", expr, err))
+		return nil
+	}
+	return re
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+)
+
+/***********************************************************************************************************************
+ * Models
+ **********************************************************************************************************************/
+
+// TeamsDataSourceModel describes the data source data model.
+type TeamsDataSourceModel struct {
+	Id          types.String                   `tfsdk:"id"`
+	Archived    types.Bool                     `tfsdk:"archived"`
+	NameRegex   types.String                   `tfsdk:"name_regex"`
+	TagRegex    types.String                   `tfsdk:"tag_regex"`
+	MemberEmail types.String                   `tfsdk:"member_email"`
+	Teams       map[string]TeamDataSourceModel `tfsdk:"teams"`
+}
+
+// FromApiModel sets Teams to the teams that match the filter, keyed by tag.
+func (o *TeamsDataSourceModel) FromApiModel(teams []cortex.Team, filter TeamsFilter) {
+	o.Id = types.StringValue("teams")
+	o.Teams = map[string]TeamDataSourceModel{}
+	for _, team := range teams {
+		if !filter.Matches(&team) {
+			continue
+		}
+		m := TeamDataSourceModel{}
+		m.FromApiModel(&team)
+		o.Teams[team.TeamTag] = m
+	}
+}
+
+/***********************************************************************************************************************
+ * Filter
+ **********************************************************************************************************************/
+
+// TeamsFilter selects teams by archived status, name and tag patterns, and member email. Unset fields match every team.
+type TeamsFilter struct {
+	Archived    *bool
+	NameRegex   *regexp.Regexp
+	TagRegex    *regexp.Regexp
+	MemberEmail string
+}
+
+func (f TeamsFilter) Matches(team *cortex.Team) bool {
+	if f.Archived != nil && team.IsArchived != *f.Archived {
+		return false
+	}
+	if f.NameRegex != nil && !f.NameRegex.MatchString(team.Metadata.Name) {
+		return false
+	}
+	if f.TagRegex != nil && !f.TagRegex.MatchString(team.TeamTag) {
+		return false
+	}
+	if f.MemberEmail != "" && !teamHasMemberEmail(team, f.MemberEmail) {
+		return false
+	}
+	return true
+}
+
+// teamHasMemberEmail reports whether any of the team's additional, IdP group or Cortex-managed members has the given
+// email, ignoring case.
+func teamHasMemberEmail(team *cortex.Team, email string) bool {
+	emails := make([]string, 0, len(team.AdditionalMembers))
+	for _, member := range team.AdditionalMembers {
+		emails = append(emails, member.Email)
+	}
+	if team.IdpGroup != nil {
+		for _, member := range team.IdpGroup.Members {
+			emails = append(emails, member.Email)
+		}
+	}
+	if team.CortexTeam != nil {
+		for _, member := range team.CortexTeam.Members {
+			emails = append(emails, member.Email)
+		}
+	}
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package provider_test
+
+import (
+	"context"
+	"github.com/cortexapps/terraform-provider-cortex/internal/cortex"
+	"github.com/cortexapps/terraform-provider-cortex/internal/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cortex_teams.all", "teams.platform-engineering.name", "Platform Engineering"),
+					resource.TestCheckResourceAttr("data.cortex_teams.all", "teams.test-archived-team.archived", "true"),
+					resource.TestCheckResourceAttr("data.cortex_teams.active", "teams.platform-engineering.tag", "platform-engineering"),
+					resource.TestCheckNoResourceAttr("data.cortex_teams.active", "teams.test-archived-team.tag"),
+					resource.TestCheckResourceAttr("data.cortex_teams.by_tag", "teams.%", "1"),
+					resource.TestCheckResourceAttr("data.cortex_teams.by_tag", "teams.test-archived-team.cortex_team.members.0.email", "jane.doe@cortex.io"),
+					resource.TestCheckResourceAttr("data.cortex_teams.by_member", "teams.platform-engineering.idp_group.members.0.email", "john.doe@cortex.io"),
+					resource.TestCheckNoResourceAttr("data.cortex_teams.by_member", "teams.test-archived-team.tag"),
+				),
+			},
+			{
+				Config: `
+data "cortex_teams" "invalid" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+		},
+	})
+}
+
+const testAccTeamsDataSourceConfig = `
+data "cortex_teams" "all" {}
+
+data "cortex_teams" "active" {
+  archived   = false
+  name_regex = "^Platform"
+}
+
+data "cortex_teams" "by_tag" {
+  tag_regex = "^test-archived-"
+}
+
+data "cortex_teams" "by_member" {
+  member_email = "John.Doe@cortex.io"
+}
+`
+
+func TestTeamsFilterMatches(t *testing.T) {
+	archived := true
+	active := false
+	team := &cortex.Team{
+		TeamTag:           "platform-engineering",
+		Metadata:          cortex.TeamMetadata{Name: "Platform Engineering"},
+		AdditionalMembers: []cortex.TeamMember{{Email: "jane.doe@cortex.io"}},
+		IdpGroup: &cortex.TeamIdpGroup{
+			Group:   "platform",
+			Members: []cortex.TeamIdpGroupMember{{Email: "john.doe@cortex.io"}},
+		},
+		CortexTeam: &cortex.TeamCortexManaged{
+			Members: []cortex.TeamMember{{Email: "joe.bloggs@cortex.io"}},
+		},
+	}
+
+	tests := map[string]struct {
+		filter   provider.TeamsFilter
+		expected bool
+	}{
+		"no filter":                 {filter: provider.TeamsFilter{}, expected: true},
+		"active":                    {filter: provider.TeamsFilter{Archived: &active}, expected: true},
+		"archived":                  {filter: provider.TeamsFilter{Archived: &archived}, expected: false},
+		"name regex":                {filter: provider.TeamsFilter{NameRegex: regexp.MustCompile("^Platform")}, expected: true},
+		"name regex mismatch":       {filter: provider.TeamsFilter{NameRegex: regexp.MustCompile("^platform")}, expected: false},
+		"tag regex":                 {filter: provider.TeamsFilter{TagRegex: regexp.MustCompile("-engineering$")}, expected: true},
+		"tag regex mismatch":        {filter: provider.TeamsFilter{TagRegex: regexp.MustCompile("^test-")}, expected: false},
+		"additional member email":   {filter: provider.TeamsFilter{MemberEmail: "jane.doe@cortex.io"}, expected: true},
+		"IdP group member email":    {filter: provider.TeamsFilter{MemberEmail: "John.Doe@Cortex.io"}, expected: true},
+		"Cortex-managed member":     {filter: provider.TeamsFilter{MemberEmail: "joe.bloggs@cortex.io"}, expected: true},
+		"member email mismatch":     {filter: provider.TeamsFilter{MemberEmail: "nobody@cortex.io"}, expected: false},
+		"every filter":              {filter: provider.TeamsFilter{Archived: &active, NameRegex: regexp.MustCompile("Engineering"), TagRegex: regexp.MustCompile("^platform"), MemberEmail: "jane.doe@cortex.io"}, expected: true},
+		"one of several mismatches": {filter: provider.TeamsFilter{Archived: &active, NameRegex: regexp.MustCompile("Engineering"), MemberEmail: "nobody@cortex.io"}, expected: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.filter.Matches(team))
+		})
+	}
+}
+
+func TestTeamsDataSourceRejectsInvalidRegex(t *testing.T) {
+	ctx := context.Background()
+	// The configuration is rejected before any request is made, so the API URL is never used.
+	server := newTestProviderServer(t, "http://127.0.0.1:0")
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.Nil(t, err, "could not get provider schema")
+
+	for _, attribute := range []string{"name_regex", "tag_regex"} {
+		t.Run(attribute, func(t *testing.T) {
+			config := testObjectValue(t, schemaResp.DataSourceSchemas["cortex_teams"].ValueType(), map[string]tftypes.Value{
+				attribute: tftypes.NewValue(tftypes.String, "("),
+			})
+			resp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{TypeName: "cortex_teams", Config: &config})
+			assert.Nil(t, err, "could not read data source")
+			if assert.Len(t, resp.Diagnostics, 1) {
+				assert.Equal(t, "Invalid Regular Expression", resp.Diagnostics[0].Summary)
+				assert.Equal(t, tftypes.NewAttributePath().WithAttributeName(attribute), resp.Diagnostics[0].Attribute)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringValueOrNull converts an optional API string to a Terraform value, treating the empty string as unset.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringValues converts Terraform string values to the strings sent to the API, or nil if there are none.
+func stringValues(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.ValueString()
+	}
+	return result
+}
+
+// stringValuesFrom converts strings from the API to Terraform string values.
+func stringValuesFrom(values []string) []types.String {
+	result := make([]types.String, len(values))
+	for i, v := range values {
+		result[i] = types.StringValue(v)
+	}
+	return result
+}